@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// parseArgs splits command arguments into positional arguments and
+// "--name value" options. An option with no value, such as "--json", is set
+// to "true", as are the named flags, which never take a value: with "ascii"
+// among them, "--ascii pikachu" leaves "pikachu" positional.
+func parseArgs(args []string, flags ...string) ([]string, map[string]string) {
+	var positional []string
+	options := make(map[string]string)
+
+	for i := 0; i < len(args); i++ {
+		if !strings.HasPrefix(args[i], "--") {
+			positional = append(positional, args[i])
+			continue
+		}
+
+		name := strings.TrimPrefix(args[i], "--")
+		if i+1 < len(args) && !strings.HasPrefix(args[i+1], "--") && !slices.Contains(flags, name) {
+			options[name] = args[i+1]
+			i++
+		} else {
+			options[name] = "true"
+		}
+	}
+
+	return positional, options
+}
+
+func intOption(options map[string]string, name string, fallback int) (int, error) {
+	value, ok := options[name]
+	if !ok {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("--%s must be a number, got %q", name, value)
+	}
+	return n, nil
+}
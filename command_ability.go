@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+)
+
+// commandAbility describes an ability and lists every Pokemon that can
+// have it.
+func commandAbility(cfg *config, args []string) error {
+	if len(args) == 0 {
+		fmt.Println("usage: ability <ability-name>")
+		return nil
+	}
+
+	ability, err := cfg.Client.GetAbility(args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(ability.Name)
+	if effect := ability.EffectText(cfg.Client.Language); effect != "" {
+		fmt.Printf("Effect: %s\n", effect)
+	}
+	if len(ability.Pokemon) == 0 {
+		fmt.Println("No Pokemon have this ability.")
+		return nil
+	}
+	fmt.Println("Pokemon:")
+	for _, p := range ability.Pokemon {
+		hidden := ""
+		if p.IsHidden {
+			hidden = " (hidden ability)"
+		}
+		fmt.Printf(" - %s%s\n", p.Pokemon.Name, hidden)
+	}
+	return nil
+}
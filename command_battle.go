@@ -0,0 +1,234 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/danalytis/pokedexcli/internal/battle"
+	"github.com/danalytis/pokedexcli/internal/pokeapi"
+)
+
+func commandBattle(cfg *config, args []string) error {
+	name, options := parseArgs(args)
+	if len(name) == 0 && cfg.Wild != nil {
+		name = []string{cfg.Wild.Pokemon}
+	}
+	if len(name) == 0 {
+		fmt.Println("usage: battle [pokemon|id] [--with <party-id>] [--level <level> (freecatch only)] [--seed <seed>]")
+		return nil
+	}
+	// As with catch, picking the opponent's level would let any Pokemon
+	// be farmed for experience, so it needs freecatch.
+	if _, ok := options["level"]; ok && !cfg.Client.Trainer.FreeCatch {
+		return fmt.Errorf("--level only works with freecatch on")
+	}
+
+	caught, player, err := playerBattler(cfg, options)
+	if err != nil {
+		return err
+	}
+	if id, err := strconv.Atoi(name[0]); err == nil && id == caught.ID {
+		return fmt.Errorf("%s (#%d) can't battle itself", caught.Species, id)
+	}
+	// Damage taken carries over until the Pokemon is healed.
+	defer func() { caught.SetHP(player.HP) }()
+	opponent, wild, err := opponentBattler(cfg, name[0], player.Level, options)
+	if err != nil {
+		return err
+	}
+
+	seed := int64(cfg.Client.Rand.Intn(1 << 30))
+	if s, ok := options["seed"]; ok {
+		seed, err = strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return fmt.Errorf("--seed must be a number, got %q", s)
+		}
+	}
+
+	b := battle.New(cfg.typeChart(), player, opponent, seed)
+	if wild {
+		fmt.Printf("A wild %s (Lv. %d) appeared!\n", opponent.Name, opponent.Level)
+	} else {
+		fmt.Printf("%s (Lv. %d) wants to battle!\n", opponent.Name, opponent.Level)
+	}
+	fmt.Printf("Go, %s! (battle seed %d)\n", player.Name, seed)
+
+	scanner := cfg.Scanner
+	if scanner == nil {
+		scanner = bufio.NewScanner(os.Stdin)
+	}
+
+	for !b.Over() {
+		printBattleStatus(b)
+		fmt.Print("Choose a move (or 'run'): ")
+		if !scanner.Scan() {
+			fmt.Println()
+			return scanner.Err()
+		}
+
+		choice := strings.TrimSpace(strings.ToLower(scanner.Text()))
+		if choice == "run" {
+			fmt.Println("Got away safely!")
+			return nil
+		}
+		index, ok := moveIndex(player, choice)
+		if !ok {
+			fmt.Printf("Pick a move from 1 to %d.\n", len(player.Moves))
+			continue
+		}
+
+		events, err := b.PlayTurn(index)
+		if err != nil {
+			return err
+		}
+		for _, e := range events {
+			fmt.Println(e)
+		}
+	}
+
+	if b.Winner() == player {
+		fmt.Printf("%s won the battle!\n", player.Name)
+		if !wild {
+			// Sparring with your own Pokemon would otherwise farm
+			// experience and EVs without limit.
+			fmt.Println("Practice battles against your own Pokemon earn no experience.")
+			return nil
+		}
+		if cfg.Wild != nil && cfg.Wild.Pokemon == opponent.Name {
+			cfg.Wild = nil
+		}
+		exp := pokeapi.ExperienceYield(opponent.BaseExperience, opponent.Level, wild)
+		levels, err := cfg.Client.AwardExperience(caught, exp)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s gained %d experience.\n", player.Name, exp)
+		if levels > 0 {
+			fmt.Printf("%s grew to level %d!\n", player.Name, caught.Level)
+		}
+		if gained := caught.AddEffort(opponent.EffortYield); gained.Total() > 0 {
+			fmt.Printf("%s gained EVs:", player.Name)
+			for _, stat := range pokeapi.StatNames {
+				if n := gained.Get(stat); n > 0 {
+					fmt.Printf(" %s +%d", stat, n)
+				}
+			}
+			fmt.Println()
+		}
+	} else {
+		fmt.Printf("%s was defeated...\n", player.Name)
+	}
+	return nil
+}
+
+// playerBattler sends out the party lead, or the party member chosen with
+// --with.
+func playerBattler(cfg *config, options map[string]string) (*pokeapi.CaughtPokemon, *battle.Battler, error) {
+	party := cfg.Client.Trainer.Party
+	if len(party) == 0 {
+		return nil, nil, fmt.Errorf("you have no Pokemon to battle with")
+	}
+
+	caught := party[0]
+	if with, ok := options["with"]; ok {
+		id, err := parseID(with)
+		if err != nil {
+			return nil, nil, err
+		}
+		p, place, found := cfg.Client.Trainer.Find(id)
+		if !found || place.Box != 0 {
+			return nil, nil, fmt.Errorf("no Pokemon with ID %d in your party", id)
+		}
+		caught = p
+	}
+	if caught.Fainted() {
+		return nil, nil, fmt.Errorf("%s has fainted; use a revive first", caught.Species)
+	}
+
+	pokemon, err := cfg.Client.FullPokemon(caught)
+	if err != nil {
+		return nil, nil, err
+	}
+	moves, err := battle.ChooseMoves(cfg.Client, pokemon, caught.Level)
+	if err != nil {
+		return nil, nil, err
+	}
+	player := battle.NewCaughtBattler(caught, moves)
+	player.HP = caught.HP()
+	return caught, player, nil
+}
+
+// opponentBattler builds the opponent from one of the trainer's own Pokemon
+// when given an ID, and from a wild Pokemon otherwise. The Pokemon from the
+// last encounter keeps its rolled level; other wild Pokemon must live in
+// the current area and match the player's level unless --level says
+// otherwise.
+func opponentBattler(cfg *config, nameOrID string, playerLevel int, options map[string]string) (*battle.Battler, bool, error) {
+	if id, err := strconv.Atoi(nameOrID); err == nil {
+		caught, _, ok := cfg.Client.Trainer.Find(id)
+		if !ok {
+			return nil, false, fmt.Errorf("you don't have a Pokemon with ID %d", id)
+		}
+		pokemon, err := cfg.Client.FullPokemon(caught)
+		if err != nil {
+			return nil, false, err
+		}
+		moves, err := battle.ChooseMoves(cfg.Client, pokemon, caught.Level)
+		if err != nil {
+			return nil, false, err
+		}
+		return battle.NewCaughtBattler(caught, moves), false, nil
+	}
+
+	defaultLevel := playerLevel
+	if cfg.Wild != nil && cfg.Wild.Pokemon == nameOrID {
+		defaultLevel = cfg.Wild.Level
+	} else if err := cfg.Client.CheckCatchable(nameOrID); err != nil {
+		return nil, false, err
+	}
+	level, err := intOption(options, "level", defaultLevel)
+	if err != nil {
+		return nil, false, err
+	}
+	if level < 1 || level > pokeapi.MaxLevel {
+		return nil, false, fmt.Errorf("--level must be between 1 and %d", pokeapi.MaxLevel)
+	}
+
+	pokemon, err := cfg.Client.GetPokemon(nameOrID)
+	if err != nil {
+		return nil, false, err
+	}
+	moves, err := battle.ChooseMoves(cfg.Client, pokemon, level)
+	if err != nil {
+		return nil, false, err
+	}
+	return battle.NewBattler(pokemon, level, moves), true, nil
+}
+
+func printBattleStatus(b *battle.Battle) {
+	fmt.Printf("\n%s Lv. %d  HP %d/%d\n", b.Opponent.Name, b.Opponent.Level, b.Opponent.HP, b.Opponent.Stats.HP)
+	fmt.Printf("%s Lv. %d  HP %d/%d\n", b.Player.Name, b.Player.Level, b.Player.HP, b.Player.Stats.HP)
+	for i, m := range b.Player.Moves {
+		power := "-"
+		if m.Power != nil {
+			power = strconv.Itoa(*m.Power)
+		}
+		fmt.Printf("  %d) %-15s %-9s power %s\n", i+1, m.Name, m.Type.Name, power)
+	}
+}
+
+// moveIndex accepts a move by its 1-based number or its name.
+func moveIndex(b *battle.Battler, choice string) (int, bool) {
+	if n, err := strconv.Atoi(choice); err == nil {
+		return n - 1, n >= 1 && n <= len(b.Moves)
+	}
+	for i, m := range b.Moves {
+		if m.Name == choice {
+			return i, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/danalytis/pokedexcli/internal/pokeapi"
+	"github.com/danalytis/pokedexcli/internal/typechart"
+)
+
+// commandCompare shows several Pokemon side by side: their size, base
+// stats and how their types fare against each other.
+func commandCompare(cfg *config, args []string) error {
+	if len(args) < 2 {
+		fmt.Println("usage: compare <pokemon> <pokemon> [pokemon...]")
+		return nil
+	}
+
+	pokemon := make([]pokeapi.Pokemon, 0, len(args))
+	for _, name := range args {
+		p, err := cfg.Client.GetPokemon(name)
+		if err != nil {
+			return fmt.Errorf("error fetching %s: %w", name, err)
+		}
+		pokemon = append(pokemon, p)
+	}
+
+	row := func(label string, value func(p pokeapi.Pokemon) string) {
+		fmt.Printf("%-16s", label)
+		for _, p := range pokemon {
+			fmt.Printf(" %-14s", value(p))
+		}
+		fmt.Println()
+	}
+	number := func(n func(p pokeapi.Pokemon) int) func(p pokeapi.Pokemon) string {
+		return func(p pokeapi.Pokemon) string { return strconv.Itoa(n(p)) }
+	}
+
+	row("", func(p pokeapi.Pokemon) string { return p.Name })
+	row("types", func(p pokeapi.Pokemon) string { return strings.Join(typechart.TypeNames(p), "/") })
+	row("height", number(func(p pokeapi.Pokemon) int { return p.Height }))
+	row("weight", number(func(p pokeapi.Pokemon) int { return p.Weight }))
+	row("base experience", number(func(p pokeapi.Pokemon) int { return p.BaseExperience }))
+	for _, stat := range pokeapi.StatNames {
+		row(stat, number(func(p pokeapi.Pokemon) int { return pokeapi.BaseStats(p).Get(stat) }))
+	}
+	row("total", number(func(p pokeapi.Pokemon) int { return pokeapi.BaseStats(p).Total() }))
+
+	matchups, err := compareMatchups(cfg.typeChart(), pokemon)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Type matchups (best attacking type):")
+	for _, m := range matchups {
+		fmt.Printf(" - %s -> %s: %s %gx\n", m.Attacker, m.Defender, m.AttackingType, m.Multiplier)
+	}
+	return nil
+}
+
+// pairMatchup is the best of one compared Pokemon's types against another.
+type pairMatchup struct {
+	Attacker, Defender string
+	typechart.Matchup
+}
+
+// compareMatchups finds, for every pair of the Pokemon in both directions,
+// the attacker's type that hits the defender hardest. The first of the
+// attacker's types wins a tie.
+func compareMatchups(chart *typechart.Chart, pokemon []pokeapi.Pokemon) ([]pairMatchup, error) {
+	var pairs []pairMatchup
+	for i, attacker := range pokemon {
+		for j, defender := range pokemon {
+			if i == j {
+				continue
+			}
+			matchups, err := chart.Matchups(attacker, defender)
+			if err != nil {
+				return nil, err
+			}
+			if len(matchups) == 0 {
+				continue
+			}
+			best := matchups[0]
+			for _, m := range matchups[1:] {
+				if m.Multiplier > best.Multiplier {
+					best = m
+				}
+			}
+			pairs = append(pairs, pairMatchup{Attacker: attacker.Name, Defender: defender.Name, Matchup: best})
+		}
+	}
+	return pairs, nil
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/danalytis/pokedexcli/internal/pokeapi"
+)
+
+func commandEncounter(cfg *config, args []string) error {
+	_, options := parseArgs(args)
+	area := cfg.Client.Trainer.Position.Area
+	if area == "" {
+		fmt.Println("Go to a location area first, e.g. goto canalave-city-area")
+		return nil
+	}
+
+	wild, err := cfg.Client.RollEncounter(area, pokeapi.EncounterFilter{
+		Method:  options["method"],
+		Version: options["version"],
+	})
+	if err != nil {
+		return err
+	}
+
+	cfg.Wild = &wild
+	fmt.Printf("A wild %s (Lv. %d) appeared! (%s, %d%% in %s)\n",
+		wild.Pokemon, wild.Level, wild.Method, wild.Chance, wild.Version)
+	fmt.Println("Use catch or battle to take it on.")
+	return nil
+}
+
+// describeEncounter summarizes how a Pokemon is found in an area, e.g.
+// "tentacool (surf, good-rod; Lv. 15-30)".
+func describeEncounter(e pokeapi.LocationPokemonEncounter) string {
+	var methods []string
+	minLevel, maxLevel := 0, 0
+	for _, vd := range e.VersionDetails {
+		for _, d := range vd.EncounterDetails {
+			if !slices.Contains(methods, d.Method.Name) {
+				methods = append(methods, d.Method.Name)
+			}
+			if minLevel == 0 || d.MinLevel < minLevel {
+				minLevel = d.MinLevel
+			}
+			maxLevel = max(maxLevel, d.MaxLevel)
+		}
+	}
+	if len(methods) == 0 {
+		return e.Pokemon.Name
+	}
+
+	levels := fmt.Sprintf("Lv. %d-%d", minLevel, maxLevel)
+	if minLevel == maxLevel {
+		levels = fmt.Sprintf("Lv. %d", minLevel)
+	}
+	return fmt.Sprintf("%s (%s; %s)", e.Pokemon.Name, strings.Join(methods, ", "), levels)
+}
+
+func commandWhere(cfg *config, args []string) error {
+	positional, options := parseArgs(args)
+	if len(positional) == 0 {
+		fmt.Println("usage: where <pokemon> [--method <method>] [--version <version>]")
+		return nil
+	}
+	name := positional[0]
+
+	encounters, err := cfg.Client.GetPokemonEncounters(name)
+	if err != nil {
+		return err
+	}
+	filter := pokeapi.EncounterFilter{Method: options["method"], Version: options["version"]}
+	slots := encounters.Slots(name, filter)
+	if len(slots) == 0 {
+		fmt.Printf("No known wild encounters for %s%s.\n", name, filter)
+		return nil
+	}
+
+	fmt.Printf("%s can be found in:\n", name)
+	for _, slot := range slots {
+		levels := fmt.Sprintf("Lv. %d-%d", slot.MinLevel, slot.MaxLevel)
+		if slot.MinLevel == slot.MaxLevel {
+			levels = fmt.Sprintf("Lv. %d", slot.MinLevel)
+		}
+		fmt.Printf(" - %-28s %-10s %-10s %3d%%  %s\n", slot.Area, slot.Version, slot.Method, slot.Chance, levels)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/danalytis/pokedexcli/internal/pokeapi"
+)
+
+func commandEvolutions(cfg *config, args []string) error {
+	if len(args) == 0 {
+		fmt.Println("usage: evolutions <pokemon-name>")
+		return nil
+	}
+
+	chain, err := cfg.Client.GetEvolutionChain(args[0])
+	if err != nil {
+		return err
+	}
+	printChainLink(chain.Chain, 0)
+	return nil
+}
+
+// printChainLink prints a species and, indented below it, everything it
+// evolves into along with how.
+func printChainLink(link pokeapi.ChainLink, depth int) {
+	line := link.Species.Name
+	if link.IsBaby {
+		line += " (baby)"
+	}
+	if depth > 0 {
+		var ways []string
+		for _, detail := range link.EvolutionDetails {
+			ways = append(ways, detail.String())
+		}
+		line = strings.Repeat("  ", depth-1) + " -> " + line
+		if len(ways) > 0 {
+			line += ": " + strings.Join(ways, " or ")
+		}
+	}
+	fmt.Println(line)
+
+	for _, next := range link.EvolvesTo {
+		printChainLink(next, depth+1)
+	}
+}
+
+func commandEvolve(cfg *config, args []string) error {
+	positional, options := parseArgs(args, "trade")
+	if len(positional) == 0 {
+		fmt.Println("usage: evolve <id> [--into <pokemon>] [--item <item>] [--trade]")
+		return nil
+	}
+	id, err := parseID(positional[0])
+	if err != nil {
+		return err
+	}
+
+	result, err := cfg.Client.Evolve(id, pokeapi.EvolveOptions{
+		Into:  options["into"],
+		Item:  options["item"],
+		Trade: options["trade"] == "true",
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("What? %s is evolving!\n", result.From)
+	fmt.Printf("Congratulations! Your %s evolved into %s!\n", result.From, result.To)
+	return nil
+}
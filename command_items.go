@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/danalytis/pokedexcli/internal/pokeapi"
+)
+
+// commandItem describes an item, and for berries also their flavors and
+// growing data.
+func commandItem(cfg *config, args []string) error {
+	if len(args) == 0 {
+		fmt.Println("usage: item <item-name>")
+		return nil
+	}
+
+	item, err := cfg.Client.GetItem(args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(item.Name)
+	if local := item.LocalizedName(cfg.Client.Language); local != item.Name {
+		fmt.Printf("Name (%s): %s\n", cfg.Client.Language, local)
+	}
+	fmt.Printf("Category: %s\n", item.Category.Name)
+	if item.Cost > 0 {
+		fmt.Printf("Cost: $%d\n", item.Cost)
+	} else {
+		fmt.Println("Cost: not sold")
+	}
+	if effect := item.EffectText(cfg.Client.Language); effect != "" {
+		fmt.Printf("Effect: %s\n", effect)
+	}
+	fmt.Printf("In your bag: %d\n", cfg.Client.Trainer.Items[item.Name])
+
+	name, ok := pokeapi.BerryName(item.Name)
+	if !ok {
+		return nil
+	}
+	berry, err := cfg.Client.GetBerry(name)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Firmness: %s\n", berry.Firmness.Name)
+	if flavors := berry.FlavorNames(); len(flavors) > 0 {
+		fmt.Printf("Flavors: %s\n", strings.Join(flavors, ", "))
+	}
+	fmt.Printf("Natural Gift: %s, power %d\n", berry.NaturalGiftType.Name, berry.NaturalGiftPower)
+	// PokeAPI's growth time is per stage, and trees have four stages.
+	fmt.Printf("Grows in %d hours, up to %d per tree\n", berry.GrowthTime*4, berry.MaxHarvest)
+	return nil
+}
+
+// commandUse uses an item from the bag on one of the trainer's Pokemon.
+func commandUse(cfg *config, args []string) error {
+	if len(args) < 2 {
+		fmt.Println("usage: use <item> <id>")
+		return nil
+	}
+	id, err := parseID(args[1])
+	if err != nil {
+		return err
+	}
+
+	result, err := cfg.Client.UseItemOn(args[0], id)
+	if err != nil {
+		return err
+	}
+
+	cp := result.Entry
+	name := cp.Species
+	if result.Evolution != nil {
+		name = result.Evolution.From
+	}
+	fmt.Printf("You used a %s on %s.\n", result.Item, name)
+	switch {
+	case result.Evolution != nil:
+		fmt.Printf("What? %s is evolving!\n", result.Evolution.From)
+		fmt.Printf("Congratulations! Your %s evolved into %s!\n", result.Evolution.From, result.Evolution.To)
+	case result.Levels > 0:
+		fmt.Printf("%s grew to level %d!\n", cp.Species, cp.Level)
+	case result.Healed > 0:
+		fmt.Printf("%s recovered %d HP (%d/%d).\n", cp.Species, result.Healed, cp.HP(), cp.Stats().HP)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// commandNickname names one of the trainer's Pokemon, or clears its
+// nickname when no name is given.
+func commandNickname(cfg *config, args []string) error {
+	if len(args) == 0 || len(args) > 2 {
+		fmt.Println("usage: nickname <id> [name]")
+		return nil
+	}
+	id, err := parseID(args[0])
+	if err != nil {
+		return err
+	}
+
+	nickname := ""
+	if len(args) > 1 {
+		nickname = args[1]
+	}
+	cp, err := cfg.Client.Trainer.SetNickname(id, nickname)
+	if err != nil {
+		return err
+	}
+	if cp.Nickname == "" {
+		fmt.Printf("#%d is just %s again.\n", cp.ID, cp.Species)
+		return nil
+	}
+	fmt.Printf("Your %s (#%d) is now called %s.\n", cp.Species, cp.ID, cp.Nickname)
+	return nil
+}
+
+// commandNote shows, replaces or clears the notes on one of the trainer's
+// Pokemon, e.g. "note 3 keep for the elite four".
+func commandNote(cfg *config, args []string) error {
+	positional, options := parseArgs(args, "clear")
+	if len(positional) == 0 {
+		fmt.Println("usage: note <id> [text...] | note <id> --clear")
+		return nil
+	}
+	id, err := parseID(positional[0])
+	if err != nil {
+		return err
+	}
+	clearNotes := options["clear"] == "true"
+	if clearNotes && len(positional) > 1 {
+		return fmt.Errorf("--clear removes the notes, it can't be given text")
+	}
+
+	trainer := cfg.Client.Trainer
+	if len(positional) == 1 && !clearNotes {
+		cp, _, ok := trainer.Find(id)
+		if !ok {
+			return fmt.Errorf("you don't have a Pokemon with ID %d", id)
+		}
+		if cp.Notes == "" {
+			fmt.Printf("#%d has no notes.\n", id)
+		} else {
+			fmt.Printf("#%d: %s\n", id, cp.Notes)
+		}
+		return nil
+	}
+
+	cp, err := trainer.SetNotes(id, strings.Join(positional[1:], " "))
+	if err != nil {
+		return err
+	}
+	if cp.Notes == "" {
+		fmt.Printf("Cleared the notes on #%d.\n", id)
+	} else {
+		fmt.Printf("Saved a note on #%d.\n", id)
+	}
+	return nil
+}
+
+// commandTag lists every tag in use, or adds tags to one of the trainer's
+// Pokemon.
+func commandTag(cfg *config, args []string) error {
+	trainer := cfg.Client.Trainer
+	if len(args) == 0 {
+		counts := trainer.TagCounts()
+		if len(counts) == 0 {
+			fmt.Println("None of your Pokemon are tagged. Try: tag <id> <tag>")
+			return nil
+		}
+		tags := make([]string, 0, len(counts))
+		for tag := range counts {
+			tags = append(tags, tag)
+		}
+		sort.Strings(tags)
+		fmt.Println("Tags:")
+		for _, tag := range tags {
+			fmt.Printf(" - %-15s %d Pokemon\n", tag, counts[tag])
+		}
+		return nil
+	}
+	if len(args) < 2 {
+		fmt.Println("usage: tag [<id> <tag> [tag...]]")
+		return nil
+	}
+
+	id, err := parseID(args[0])
+	if err != nil {
+		return err
+	}
+	cp, err := trainer.AddTags(id, args[1:]...)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("#%d is tagged: %s\n", cp.ID, strings.Join(cp.Tags, ", "))
+	return nil
+}
+
+func commandUntag(cfg *config, args []string) error {
+	if len(args) < 2 {
+		fmt.Println("usage: untag <id> <tag> [tag...]")
+		return nil
+	}
+	id, err := parseID(args[0])
+	if err != nil {
+		return err
+	}
+
+	cp, err := cfg.Client.Trainer.RemoveTags(id, args[1:]...)
+	if err != nil {
+		return err
+	}
+	if len(cp.Tags) == 0 {
+		fmt.Printf("#%d has no tags left.\n", cp.ID)
+	} else {
+		fmt.Printf("#%d is tagged: %s\n", cp.ID, strings.Join(cp.Tags, ", "))
+	}
+	return nil
+}
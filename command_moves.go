@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// commandMoves lists a Pokemon's learnset in one version group, grouped by
+// how each move is learned.
+func commandMoves(cfg *config, args []string) error {
+	positional, options := parseArgs(args)
+	if len(positional) == 0 {
+		fmt.Println("usage: moves <pokemon> [--version-group <group>] [--method level-up|machine|egg|tutor]")
+		return nil
+	}
+
+	pokemon, err := cfg.Client.GetPokemon(positional[0])
+	if err != nil {
+		return err
+	}
+	groups := pokemon.VersionGroups()
+	if len(groups) == 0 {
+		fmt.Printf("%s has no known moves.\n", pokemon.Name)
+		return nil
+	}
+	group := options["version-group"]
+	if group == "" {
+		group = groups[0]
+	}
+
+	learnset := pokemon.Learnset(group)
+	if len(learnset) == 0 {
+		return fmt.Errorf("%s has no moves in %s, try one of %s", pokemon.Name, group, strings.Join(groups, ", "))
+	}
+
+	fmt.Printf("%s's moves in %s:\n", pokemon.Name, group)
+	method := ""
+	for _, m := range learnset {
+		if options["method"] != "" && m.Method != options["method"] {
+			continue
+		}
+		if m.Method != method {
+			method = m.Method
+			fmt.Printf("%s:\n", method)
+		}
+		if m.Method == "level-up" {
+			fmt.Printf(" - Lv. %-3d %s\n", m.Level, m.Move)
+		} else {
+			fmt.Printf(" - %s\n", m.Move)
+		}
+	}
+	if method == "" {
+		fmt.Printf("%s learns no moves by %s in %s.\n", pokemon.Name, options["method"], group)
+	}
+	return nil
+}
+
+func commandMove(cfg *config, args []string) error {
+	if len(args) == 0 {
+		fmt.Println("usage: move <move-name>")
+		return nil
+	}
+
+	move, err := cfg.Client.GetMove(args[0])
+	if err != nil {
+		return err
+	}
+
+	orDash := func(n *int) string {
+		if n == nil {
+			return "-"
+		}
+		return strconv.Itoa(*n)
+	}
+	fmt.Printf("%s\n", move.Name)
+	fmt.Printf(" - type: %s (%s)\n", move.Type.Name, move.DamageClass.Name)
+	fmt.Printf(" - power: %s, accuracy: %s, PP: %d, priority: %d\n",
+		orDash(move.Power), orDash(move.Accuracy), move.PP, move.Priority)
+	if effect := move.EffectText(cfg.Client.Language); effect != "" {
+		fmt.Printf(" - effect: %s\n", effect)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/danalytis/pokedexcli/internal/pokeapi"
+)
+
+func printCaught(pokemon []*pokeapi.CaughtPokemon) {
+	for _, p := range pokemon {
+		fmt.Printf(" - #%-3d %-12s Lv. %-3d caught %s", p.ID, p.Species, p.Level, p.CaughtAt.Format("2006-01-02"))
+		if p.Location != "" {
+			fmt.Printf(" at %s", p.Location)
+		}
+		if p.Nickname != "" {
+			fmt.Printf(" %q", p.Nickname)
+		}
+		if p.Fainted() {
+			fmt.Print(" (fainted)")
+		} else if p.Damage > 0 {
+			fmt.Printf(" (HP %d/%d)", p.HP(), p.Stats().HP)
+		}
+		fmt.Println()
+	}
+}
+
+func parseID(arg string) (int, error) {
+	id, err := strconv.Atoi(arg)
+	if err != nil {
+		return 0, fmt.Errorf("expected a Pokemon ID, got %q", arg)
+	}
+	return id, nil
+}
+
+func commandParty(cfg *config, args []string) error {
+	party := cfg.Client.Trainer.Party
+	if len(party) == 0 {
+		fmt.Println("Your party is empty.")
+		return nil
+	}
+	fmt.Printf("Your party (%d/%d):\n", len(party), pokeapi.PartySize)
+	printCaught(party)
+	return nil
+}
+
+func commandBox(cfg *config, args []string) error {
+	boxes := cfg.Client.Trainer.Boxes
+	if len(args) == 0 {
+		for i, box := range boxes {
+			fmt.Printf(" - Box %d: %d/%d\n", i+1, len(box), pokeapi.BoxSize)
+		}
+		return nil
+	}
+
+	n, err := strconv.Atoi(args[0])
+	if err != nil || n < 1 || n > len(boxes) {
+		return fmt.Errorf("box must be between 1 and %d", len(boxes))
+	}
+	box := boxes[n-1]
+	if len(box) == 0 {
+		fmt.Printf("Box %d is empty.\n", n)
+		return nil
+	}
+	fmt.Printf("Box %d (%d/%d):\n", n, len(box), pokeapi.BoxSize)
+	printCaught(box)
+	return nil
+}
+
+func commandDeposit(cfg *config, args []string) error {
+	if len(args) == 0 {
+		fmt.Println("usage: deposit <id> [box]")
+		return nil
+	}
+	id, err := parseID(args[0])
+	if err != nil {
+		return err
+	}
+	box := 0
+	if len(args) > 1 {
+		box, err = strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("expected a box number, got %q", args[1])
+		}
+	}
+
+	box, err = cfg.Client.Trainer.Deposit(id, box)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Deposited #%d in box %d.\n", id, box)
+	return nil
+}
+
+func commandWithdraw(cfg *config, args []string) error {
+	if len(args) == 0 {
+		fmt.Println("usage: withdraw <id>")
+		return nil
+	}
+	id, err := parseID(args[0])
+	if err != nil {
+		return err
+	}
+
+	if err := cfg.Client.Trainer.Withdraw(id); err != nil {
+		return err
+	}
+	fmt.Printf("Withdrew #%d to your party.\n", id)
+	return nil
+}
+
+func commandRelease(cfg *config, args []string) error {
+	if len(args) == 0 {
+		fmt.Println("usage: release <id>")
+		return nil
+	}
+	id, err := parseID(args[0])
+	if err != nil {
+		return err
+	}
+
+	released, err := cfg.Client.Trainer.Release(id)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s (#%d) was released. Bye-bye, %s!\n", released.Species, released.ID, released.Species)
+	return nil
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/danalytis/pokedexcli/internal/pokeapi"
+)
+
+const pokedexPageSize = 20
+
+// commandPokedex lists caught Pokemon one page at a time, e.g.
+// "pokedex pi* --type electric --min-speed 90 --sort speed --page 2" or
+// "pokedex --tag competitive".
+func commandPokedex(cfg *config, args []string) error {
+	positional, options := parseArgs(args, "reverse")
+	query := pokeapi.DexQuery{
+		Type:     options["type"],
+		Tag:      options["tag"],
+		Note:     options["note"],
+		Sort:     options["sort"],
+		Reverse:  options["reverse"] == "true",
+		MinStats: make(map[string]int),
+	}
+	if len(positional) > 0 {
+		query.Name = positional[0]
+	}
+	if gen, ok := options["gen"]; ok {
+		name, err := pokeapi.GenerationName(gen)
+		if err != nil {
+			return err
+		}
+		query.Generation = name
+	}
+	for _, stat := range pokeapi.StatNames {
+		if _, ok := options["min-"+stat]; !ok {
+			continue
+		}
+		minimum, err := intOption(options, "min-"+stat, 0)
+		if err != nil {
+			return err
+		}
+		query.MinStats[stat] = minimum
+	}
+	page, err := intOption(options, "page", 1)
+	if err != nil {
+		return err
+	}
+	perPage, err := intOption(options, "per-page", pokedexPageSize)
+	if err != nil {
+		return err
+	}
+	if page < 1 || perPage < 1 {
+		return fmt.Errorf("--page and --per-page must be positive")
+	}
+
+	entries, err := cfg.Client.QueryPokedex(query)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		if len(cfg.Client.Trainer.Owned()) == 0 {
+			fmt.Println("Your Pokedex is empty.")
+		} else {
+			fmt.Println("None of your Pokemon match.")
+		}
+		return nil
+	}
+
+	pages := (len(entries) + perPage - 1) / perPage
+	if page > pages {
+		return fmt.Errorf("page %d is past the last page, %d", page, pages)
+	}
+	start := (page - 1) * perPage
+	end := min(start+perPage, len(entries))
+
+	fmt.Printf("Your Pokedex (page %d/%d, %d Pokemon):\n", page, pages, len(entries))
+	for _, e := range entries[start:end] {
+		_, place, _ := cfg.Client.Trainer.Find(e.ID)
+		fmt.Printf(" - No.%03d #%-3d %-12s Lv. %-3d %s (%s)",
+			e.Number, e.ID, e.Species, e.Level, e.CaughtAt.Format("2006-01-02"), place)
+		if e.Nickname != "" {
+			fmt.Printf(" %q", e.Nickname)
+		}
+		if len(e.Tags) > 0 {
+			fmt.Printf(" [%s]", strings.Join(e.Tags, ", "))
+		}
+		fmt.Println()
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/danalytis/pokedexcli/internal/pokeapi"
+)
+
+// progressKinds are the lists progress can measure, each named after the
+// PokeAPI resource it reads.
+var progressKinds = []string{"pokedex", "generation", "type"}
+
+// commandProgress shows how complete the trainer's dex is. With no
+// arguments it covers the national dex and the current region; otherwise
+// it covers one pokedex, generation or type, or all of them.
+func commandProgress(cfg *config, args []string) error {
+	if len(args) == 0 {
+		return printRegionProgress(cfg)
+	}
+
+	kind := strings.TrimSuffix(args[0], "s")
+	if kind == "dex" {
+		kind = "pokedex"
+	}
+	if !slices.Contains(progressKinds, kind) {
+		fmt.Println("usage: progress [pokedex|generation|type] [name]")
+		return nil
+	}
+
+	if len(args) == 1 {
+		list, err := cfg.Client.GetResourceList(cfg.Client.PokeapiBaseURL + kind + "?limit=100")
+		if err != nil {
+			return err
+		}
+		for _, resource := range list.Results {
+			progress, err := dexProgress(cfg, kind, resource.Name)
+			if err != nil {
+				return err
+			}
+			printProgress(progress)
+		}
+		return nil
+	}
+
+	progress, err := dexProgress(cfg, kind, args[1])
+	if err != nil {
+		return err
+	}
+	printProgress(progress)
+	printMissing(progress)
+	return nil
+}
+
+// dexProgress measures the trainer against the species in one pokedex or
+// generation, or the Pokemon of one type.
+func dexProgress(cfg *config, kind, name string) (pokeapi.DexProgress, error) {
+	trainer := cfg.Client.Trainer
+	switch kind {
+	case "pokedex":
+		dex, err := cfg.Client.GetPokedex(name)
+		if err != nil {
+			return pokeapi.DexProgress{}, err
+		}
+		return trainer.Progress(dex.Name, dex.Species()), nil
+	case "generation":
+		generation, err := cfg.Client.GetGeneration(name)
+		if err != nil {
+			return pokeapi.DexProgress{}, err
+		}
+		return trainer.Progress(generation.Name, generation.Species()), nil
+	default:
+		t, err := cfg.Client.GetType(name)
+		if err != nil {
+			return pokeapi.DexProgress{}, err
+		}
+		species, err := cfg.Client.TypeSpecies(t)
+		if err != nil {
+			return pokeapi.DexProgress{}, err
+		}
+		return trainer.Progress(t.Name, species), nil
+	}
+}
+
+func printRegionProgress(cfg *config) error {
+	national, err := dexProgress(cfg, "pokedex", "national")
+	if err != nil {
+		return err
+	}
+	printProgress(national)
+
+	position := cfg.Client.Trainer.Position
+	if position.Region == "" {
+		fmt.Println("Go to a location area to see what's missing in its region.")
+		return nil
+	}
+	region, err := cfg.Client.GetRegion(position.Region)
+	if err != nil {
+		return err
+	}
+	if region.MainGeneration != nil {
+		generation, err := dexProgress(cfg, "generation", region.MainGeneration.Name)
+		if err != nil {
+			return err
+		}
+		printProgress(generation)
+	}
+	for _, dex := range region.Pokedexes {
+		progress, err := dexProgress(cfg, "pokedex", dex.Name)
+		if err != nil {
+			return err
+		}
+		printProgress(progress)
+		printMissing(progress)
+	}
+	return nil
+}
+
+func printProgress(p pokeapi.DexProgress) {
+	fmt.Printf("%-18s %4d/%-4d caught (%5.1f%%), %d seen\n", p.Name, p.Caught, p.Total, p.Percent(), p.Seen)
+}
+
+func printMissing(p pokeapi.DexProgress) {
+	if len(p.Missing) == 0 {
+		fmt.Printf("You've caught every Pokemon in %s!\n", p.Name)
+		return
+	}
+	fmt.Printf("Still missing from %s:\n", p.Name)
+	for _, name := range p.Missing {
+		fmt.Printf(" - %s\n", name)
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+)
+
+func commandRegions(cfg *config, args []string) error {
+	return cfg.pageForward("region")
+}
+
+// commandLocations lists a region's locations, or pages through every
+// location when no region is given.
+func commandLocations(cfg *config, args []string) error {
+	if len(args) == 0 {
+		return cfg.pageForward("location")
+	}
+
+	region, err := cfg.Client.GetRegion(args[0])
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Locations in %s:\n", region.Name)
+	for _, location := range region.Locations {
+		fmt.Printf(" - %s\n", location.Name)
+	}
+	return nil
+}
+
+func commandAreas(cfg *config, args []string) error {
+	if len(args) == 0 {
+		fmt.Println("usage: areas <location>")
+		return nil
+	}
+
+	location, err := cfg.Client.GetLocation(args[0])
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Areas in %s (%s):\n", location.Name, location.Region.Name)
+	for _, area := range location.Areas {
+		marker := ""
+		if area.Name == cfg.Client.Trainer.Position.Area {
+			marker = " (you are here)"
+		}
+		fmt.Printf(" - %s%s\n", area.Name, marker)
+	}
+	return nil
+}
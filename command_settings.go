@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+)
+
+// commandSet shows or changes the language text is shown in and the game
+// version Pokedex entries come from.
+func commandSet(cfg *config, args []string) error {
+	if len(args) == 0 {
+		version := cfg.Client.Version
+		if version == "" {
+			version = "latest"
+		}
+		fmt.Printf("lang: %s\nversion: %s\n", cfg.Client.Language, version)
+		return nil
+	}
+	if len(args) < 2 {
+		fmt.Println("usage: set [lang <code>|version <game|latest>]")
+		return nil
+	}
+
+	switch args[0] {
+	case "lang":
+		cfg.Client.Language = args[1]
+	case "version":
+		cfg.Client.Version = args[1]
+		if args[1] == "latest" {
+			cfg.Client.Version = ""
+		}
+	default:
+		return fmt.Errorf("unknown setting %q, expected lang or version", args[0])
+	}
+	fmt.Printf("%s set to %s.\n", args[0], args[1])
+	return nil
+}
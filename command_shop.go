@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/danalytis/pokedexcli/internal/pokeapi"
+)
+
+func commandInventory(cfg *config, args []string) error {
+	trainer := cfg.Client.Trainer
+	fmt.Printf("Money: $%d\n", trainer.Money)
+	if len(trainer.Items) == 0 {
+		fmt.Println("Your bag is empty.")
+		return nil
+	}
+
+	names := make([]string, 0, len(trainer.Items))
+	for name := range trainer.Items {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Println("Items:")
+	for _, name := range names {
+		fmt.Printf(" - %-15s x%d\n", name, trainer.Items[name])
+	}
+	return nil
+}
+
+func commandShop(cfg *config, args []string) error {
+	if len(args) == 0 {
+		fmt.Printf("You have $%d. For sale:\n", cfg.Client.Trainer.Money)
+		for _, name := range pokeapi.ShopItems {
+			item, err := cfg.Client.GetItem(name)
+			if err != nil {
+				return err
+			}
+			fmt.Printf(" - %-15s $%d\n", item.Name, item.Cost)
+		}
+		return nil
+	}
+
+	if args[0] != "buy" || len(args) < 2 {
+		fmt.Println("usage: shop | shop buy <item> [quantity]")
+		return nil
+	}
+
+	quantity := 1
+	if len(args) > 2 {
+		n, err := strconv.Atoi(args[2])
+		if err != nil {
+			return fmt.Errorf("quantity must be a number, got %q", args[2])
+		}
+		quantity = n
+	}
+
+	spent, err := cfg.Client.BuyItem(args[1], quantity)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Bought %d %s for $%d. You have $%d left.\n", quantity, args[1], spent, cfg.Client.Trainer.Money)
+	return nil
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/danalytis/pokedexcli/internal/pokeapi"
+)
+
+func commandGoto(cfg *config, args []string) error {
+	positional, options := parseArgs(args, "any-region")
+	if len(positional) == 0 {
+		fmt.Printf("You are at %s.\n", cfg.Client.Trainer.Position)
+		fmt.Println("usage: goto <location-area> [--any-region]")
+		return nil
+	}
+
+	position, err := cfg.Client.Goto(positional[0], pokeapi.GotoOptions{
+		AnyRegion: options["any-region"] == "true",
+	})
+	if errors.Is(err, pokeapi.ErrDifferentRegion) {
+		fmt.Println("Pass --any-region to travel to another region.")
+	}
+	if err != nil {
+		return err
+	}
+
+	cfg.Wild = nil
+	fmt.Printf("You arrived at %s.\n", position)
+	return nil
+}
+
+func commandFreeCatch(cfg *config, args []string) error {
+	if len(args) > 0 {
+		switch args[0] {
+		case "on":
+			cfg.Client.Trainer.FreeCatch = true
+		case "off":
+			cfg.Client.Trainer.FreeCatch = false
+		default:
+			fmt.Println("usage: freecatch [on|off]")
+			return nil
+		}
+	}
+
+	if cfg.Client.Trainer.FreeCatch {
+		fmt.Println("Free-catch mode is on: any Pokemon can be caught anywhere.")
+	} else {
+		fmt.Println("Free-catch mode is off: only Pokemon found in your current area can be caught.")
+	}
+	return nil
+}
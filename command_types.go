@@ -0,0 +1,121 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/danalytis/pokedexcli/internal/pokeapi"
+	"github.com/danalytis/pokedexcli/internal/typechart"
+)
+
+// typeChart returns the session's type chart, creating it on first use so
+// its rows are shared between commands.
+func (cfg *config) typeChart() *typechart.Chart {
+	if cfg.Types == nil {
+		cfg.Types = typechart.New(cfg.Client)
+	}
+	return cfg.Types
+}
+
+func formatList(types []string) string {
+	if len(types) == 0 {
+		return "none"
+	}
+	return strings.Join(types, ", ")
+}
+
+func commandType(cfg *config, args []string) error {
+	if len(args) == 0 {
+		fmt.Println("usage: type <type-name>")
+		return nil
+	}
+
+	s, err := cfg.typeChart().Summarize(args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s attacking:\n", s.Name)
+	fmt.Printf(" - super effective against: %s\n", formatList(s.SuperEffectiveAgainst))
+	fmt.Printf(" - not very effective against: %s\n", formatList(s.NotVeryEffective))
+	fmt.Printf(" - no effect against: %s\n", formatList(s.NoEffectAgainst))
+	fmt.Printf("%s defending:\n", s.Name)
+	fmt.Printf(" - weak to: %s\n", formatList(s.WeakTo))
+	fmt.Printf(" - resists: %s\n", formatList(s.Resists))
+	fmt.Printf(" - immune to: %s\n", formatList(s.ImmuneTo))
+	return nil
+}
+
+func commandMatchup(cfg *config, args []string) error {
+	if len(args) < 2 {
+		fmt.Println("usage: matchup <attacker> <defender>")
+		return nil
+	}
+
+	attacker, err := cfg.Client.GetPokemon(args[0])
+	if err != nil {
+		return err
+	}
+	defender, err := cfg.Client.GetPokemon(args[1])
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s (%s) vs %s (%s)\n",
+		attacker.Name, strings.Join(typechart.TypeNames(attacker), "/"),
+		defender.Name, strings.Join(typechart.TypeNames(defender), "/"))
+
+	for _, pair := range [][2]pokeapi.Pokemon{{attacker, defender}, {defender, attacker}} {
+		a, d := pair[0], pair[1]
+		matchups, err := cfg.typeChart().Matchups(a, d)
+		if err != nil {
+			return err
+		}
+		for _, m := range matchups {
+			fmt.Printf(" - %s's %s moves: %gx against %s\n", a.Name, m.AttackingType, m.Multiplier, d.Name)
+		}
+	}
+	return nil
+}
+
+func commandAnalyze(cfg *config, args []string) error {
+	positional, options := parseArgs(args, "json")
+	if len(positional) == 0 || positional[0] != "team" {
+		fmt.Println("usage: analyze team [--json]")
+		return nil
+	}
+
+	var team []pokeapi.Pokemon
+	for _, p := range cfg.Client.Trainer.Party {
+		team = append(team, p.Pokemon)
+	}
+	if len(team) == 0 {
+		fmt.Println("Your party is empty.")
+		return nil
+	}
+
+	analysis, err := cfg.typeChart().AnalyzeTeam(team)
+	if err != nil {
+		return err
+	}
+
+	if options["json"] == "true" {
+		data, err := json.MarshalIndent(analysis, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	fmt.Printf("Team: %s\n", strings.Join(analysis.Members, ", "))
+	fmt.Printf("%-10s %5s %7s %7s  %s\n", "Type", "Weak", "Resist", "Immune", "Hit super effectively by")
+	for i, d := range analysis.Defense {
+		hitBy := analysis.Offense[i].HitBy
+		fmt.Printf("%-10s %5d %7d %7d  %s\n", d.Type, len(d.Weak), len(d.Resist), len(d.Immune), formatList(hitBy))
+	}
+	fmt.Printf("Threats: %s\n", formatList(analysis.Threats))
+	fmt.Printf("No super effective coverage against: %s\n", formatList(analysis.Uncovered))
+	return nil
+}
@@ -0,0 +1,255 @@
+// Package battle simulates turn-based fights between two Pokemon using their
+// real stats, types and moves from PokeAPI.
+package battle
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+
+	"github.com/danalytis/pokedexcli/internal/pokeapi"
+	"github.com/danalytis/pokedexcli/internal/typechart"
+)
+
+const (
+	MaxMoves = 4
+	// maxMoveScan bounds how many of a Pokemon's moves are fetched when
+	// looking for damaging ones.
+	maxMoveScan = 20
+)
+
+// Battler is one side of a battle.
+type Battler struct {
+	Name           string
+	Level          int
+	Types          []string
+	Stats          pokeapi.StatSet
+	HP             int
+	Moves          []pokeapi.Move
+	BaseExperience int
+	// EffortYield is the EVs the winner earns for defeating this Pokemon.
+	EffortYield pokeapi.StatSet
+}
+
+// NewBattler builds a wild Pokemon with no IVs or EVs and a neutral nature.
+func NewBattler(p pokeapi.Pokemon, level int, moves []pokeapi.Move) *Battler {
+	var none pokeapi.StatSet
+	stats := pokeapi.CalculateStats(pokeapi.BaseStats(p), none, none, level, pokeapi.NatureByName(""))
+	return newBattler(p, level, stats, moves)
+}
+
+// NewCaughtBattler builds one of the trainer's own Pokemon at its level,
+// with its IVs, EVs and nature.
+func NewCaughtBattler(cp *pokeapi.CaughtPokemon, moves []pokeapi.Move) *Battler {
+	return newBattler(cp.Pokemon, cp.Level, cp.Stats(), moves)
+}
+
+func newBattler(p pokeapi.Pokemon, level int, stats pokeapi.StatSet, moves []pokeapi.Move) *Battler {
+	return &Battler{
+		Name:           p.Name,
+		Level:          level,
+		Types:          typechart.TypeNames(p),
+		Stats:          stats,
+		HP:             stats.HP,
+		Moves:          moves,
+		BaseExperience: p.BaseExperience,
+		EffortYield:    pokeapi.EffortYield(p),
+	}
+}
+
+func (b *Battler) Fainted() bool {
+	return b.HP <= 0
+}
+
+// ChooseMoves picks the damaging moves a Pokemon knows at level: the
+// latest MaxMoves it has learned by levelling up, in the version group it
+// has the most moves in. A Pokemon that learns none that way uses any of
+// its moves, and Struggle if none of them do damage.
+func ChooseMoves(client *pokeapi.Client, p pokeapi.Pokemon, level int) ([]pokeapi.Move, error) {
+	var levelUp []string
+	if groups := p.VersionGroups(); len(groups) > 0 {
+		for _, m := range p.Learnset(groups[0]) {
+			if m.Method == "level-up" && m.Level <= level {
+				levelUp = append(levelUp, m.Move)
+			}
+		}
+	}
+	slices.Reverse(levelUp)
+	moves, err := damagingMoves(client, levelUp)
+	if err != nil {
+		return nil, err
+	}
+	// Keep the order they were learned in.
+	slices.Reverse(moves)
+
+	if len(moves) == 0 {
+		var all []string
+		for _, pm := range p.Moves {
+			all = append(all, pm.Move.Name)
+		}
+		if moves, err = damagingMoves(client, all); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(moves) == 0 {
+		struggle, err := client.GetMove("struggle")
+		if err != nil {
+			return nil, err
+		}
+		moves = append(moves, struggle)
+	}
+	return moves, nil
+}
+
+// damagingMoves fetches the first MaxMoves of the named moves that do
+// damage, looking at no more than maxMoveScan of them.
+func damagingMoves(client *pokeapi.Client, names []string) ([]pokeapi.Move, error) {
+	var moves []pokeapi.Move
+	var scanned []string
+	for _, name := range names {
+		if len(scanned) >= maxMoveScan || len(moves) >= MaxMoves {
+			break
+		}
+		if slices.Contains(scanned, name) {
+			continue
+		}
+		scanned = append(scanned, name)
+		move, err := client.GetMove(name)
+		if err != nil {
+			return nil, err
+		}
+		if move.Power != nil && *move.Power > 0 {
+			moves = append(moves, move)
+		}
+	}
+	return moves, nil
+}
+
+// Battle is a single fight between the player's Pokemon and an opponent.
+// All randomness comes from the seed, so the same seed and the same choices
+// replay the same fight.
+type Battle struct {
+	Player   *Battler
+	Opponent *Battler
+	Turn     int
+
+	chart *typechart.Chart
+	rng   *rand.Rand
+}
+
+func New(chart *typechart.Chart, player, opponent *Battler, seed int64) *Battle {
+	return &Battle{
+		Player:   player,
+		Opponent: opponent,
+		chart:    chart,
+		rng:      rand.New(rand.NewSource(seed)),
+	}
+}
+
+func (b *Battle) Over() bool {
+	return b.Player.Fainted() || b.Opponent.Fainted()
+}
+
+// Winner returns the side still standing, or nil while the battle is on.
+func (b *Battle) Winner() *Battler {
+	switch {
+	case b.Opponent.Fainted():
+		return b.Player
+	case b.Player.Fainted():
+		return b.Opponent
+	}
+	return nil
+}
+
+// PlayTurn has the player use the move at moveIndex while the opponent
+// picks one at random. The faster Pokemon (or the higher-priority move)
+// goes first, and the second attack is skipped if its user has fainted.
+func (b *Battle) PlayTurn(moveIndex int) ([]Event, error) {
+	if b.Over() {
+		return nil, fmt.Errorf("the battle is over")
+	}
+	if moveIndex < 0 || moveIndex >= len(b.Player.Moves) {
+		return nil, fmt.Errorf("move must be between 1 and %d", len(b.Player.Moves))
+	}
+	b.Turn++
+
+	playerMove := b.Player.Moves[moveIndex]
+	opponentMove := b.Opponent.Moves[b.rng.Intn(len(b.Opponent.Moves))]
+
+	type action struct {
+		attacker, defender *Battler
+		move               pokeapi.Move
+	}
+	first := action{b.Player, b.Opponent, playerMove}
+	second := action{b.Opponent, b.Player, opponentMove}
+	if b.goesSecond(playerMove, opponentMove) {
+		first, second = second, first
+	}
+
+	var events []Event
+	for _, a := range []action{first, second} {
+		if a.attacker.Fainted() {
+			break
+		}
+		event, err := b.attack(a.attacker, a.defender, a.move)
+		if err != nil {
+			return events, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func (b *Battle) goesSecond(playerMove, opponentMove pokeapi.Move) bool {
+	if playerMove.Priority != opponentMove.Priority {
+		return playerMove.Priority < opponentMove.Priority
+	}
+	if b.Player.Stats.Speed != b.Opponent.Stats.Speed {
+		return b.Player.Stats.Speed < b.Opponent.Stats.Speed
+	}
+	return b.rng.Intn(2) == 0
+}
+
+func (b *Battle) attack(attacker, defender *Battler, move pokeapi.Move) (Event, error) {
+	event := Event{
+		Attacker:      attacker.Name,
+		Defender:      defender.Name,
+		Move:          move.Name,
+		Effectiveness: 1,
+	}
+
+	if move.Accuracy != nil && b.rng.Intn(100) >= *move.Accuracy {
+		event.Missed = true
+		return event, nil
+	}
+	if move.Power == nil || *move.Power == 0 {
+		return event, nil
+	}
+
+	effectiveness, err := b.chart.Effectiveness(move.Type.Name, defender.Types)
+	if err != nil {
+		return Event{}, err
+	}
+	event.Effectiveness = effectiveness
+
+	attack, defense := attacker.Stats.Attack, defender.Stats.Defense
+	if move.DamageClass.Name == "special" {
+		attack, defense = attacker.Stats.SpecialAttack, defender.Stats.SpecialDefense
+	}
+
+	stab := 1.0
+	for _, t := range attacker.Types {
+		if t == move.Type.Name {
+			stab = 1.5
+		}
+	}
+
+	event.Critical = b.rng.Intn(critChance) == 0
+	random := float64(85+b.rng.Intn(16)) / 100
+
+	event.Damage = Damage(attacker.Level, *move.Power, attack, defense, stab, effectiveness, event.Critical, random)
+	defender.HP = max(0, defender.HP-event.Damage)
+	event.Fainted = defender.Fainted()
+	return event, nil
+}
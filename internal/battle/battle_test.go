@@ -0,0 +1,235 @@
+package battle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/danalytis/pokedexcli/internal/pokeapi"
+	"github.com/danalytis/pokedexcli/internal/pokeapitest"
+	"github.com/danalytis/pokedexcli/internal/pokecache"
+	"github.com/danalytis/pokedexcli/internal/typechart"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(t *testing.T) *pokeapi.Client {
+	t.Helper()
+	server := pokeapitest.NewServer()
+	t.Cleanup(server.Close)
+
+	cache := pokecache.NewCache(5 * time.Minute)
+	return pokeapi.NewClientWithBaseURL(&cache, server.BaseURL())
+}
+
+func newTestBattler(t *testing.T, client *pokeapi.Client, name string, level int) *Battler {
+	t.Helper()
+	p, err := client.GetPokemon(name)
+	assert.NoError(t, err)
+	moves, err := ChooseMoves(client, p, level)
+	assert.NoError(t, err)
+	return NewBattler(p, level, moves)
+}
+
+func TestDamage(t *testing.T) {
+	cases := []struct {
+		name          string
+		stab          float64
+		effectiveness float64
+		critical      bool
+		expected      int
+	}{
+		{"neutral", 1, 1, false, 41},
+		{"stab", 1.5, 1, false, 61},
+		{"super effective", 1, 2, false, 82},
+		{"resisted", 1, 0.5, false, 20},
+		{"immune", 1.5, 0, false, 0},
+		{"critical", 1, 1, true, 61},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			// A level 50, 90 power move with equal attack and defense.
+			actual := Damage(50, 90, 100, 100, c.stab, c.effectiveness, c.critical, 1)
+			assert.Equal(t, c.expected, actual)
+		})
+	}
+}
+
+func TestDamage_Minimum(t *testing.T) {
+	assert.Equal(t, 1, Damage(1, 10, 5, 500, 1, 0.25, false, 0.85))
+}
+
+func moveNames(moves []pokeapi.Move) []string {
+	var names []string
+	for _, m := range moves {
+		names = append(names, m.Name)
+	}
+	return names
+}
+
+func TestChooseMoves_LevelUpMovesUpToLevel(t *testing.T) {
+	client := newTestClient(t)
+	p, err := client.GetPokemon("pikachu")
+	assert.NoError(t, err)
+
+	// In diamond-pearl pikachu learns thunder-shock at 1, thunder-wave
+	// (a status move) at 8 and quick-attack at 13; thunderbolt is a TM.
+	for _, c := range []struct {
+		level int
+		moves []string
+	}{
+		{5, []string{"thunder-shock"}},
+		{13, []string{"thunder-shock", "quick-attack"}},
+		{100, []string{"thunder-shock", "quick-attack"}},
+	} {
+		moves, err := ChooseMoves(client, p, c.level)
+		assert.NoError(t, err)
+		assert.Equal(t, c.moves, moveNames(moves), "level %d", c.level)
+	}
+}
+
+func TestChooseMoves_KeepsLatestFour(t *testing.T) {
+	client := newTestClient(t)
+	p := pokeapi.Pokemon{Name: "test"}
+	for i, name := range []string{"scratch", "tackle", "ember", "water-gun", "thunder-shock"} {
+		p.Moves = append(p.Moves, pokeapi.PokemonMove{
+			Move: pokeapi.NamedAPIResource{Name: name},
+			VersionGroupDetails: []pokeapi.MoveVersionDetail{{
+				LevelLearnedAt:  i * 10,
+				MoveLearnMethod: pokeapi.NamedAPIResource{Name: "level-up"},
+				VersionGroup:    pokeapi.NamedAPIResource{Name: "diamond-pearl"},
+			}},
+		})
+	}
+
+	moves, err := ChooseMoves(client, p, 40)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"tackle", "ember", "water-gun", "thunder-shock"}, moveNames(moves))
+}
+
+func TestChooseMoves_FallsBackToOtherMoves(t *testing.T) {
+	client := newTestClient(t)
+	p := pokeapi.Pokemon{Name: "test", Moves: []pokeapi.PokemonMove{{
+		Move: pokeapi.NamedAPIResource{Name: "thunderbolt"},
+		VersionGroupDetails: []pokeapi.MoveVersionDetail{{
+			MoveLearnMethod: pokeapi.NamedAPIResource{Name: "machine"},
+			VersionGroup:    pokeapi.NamedAPIResource{Name: "diamond-pearl"},
+		}},
+	}}}
+
+	moves, err := ChooseMoves(client, p, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"thunderbolt"}, moveNames(moves))
+}
+
+func TestChooseMoves_FallsBackToStruggle(t *testing.T) {
+	client := newTestClient(t)
+
+	moves, err := ChooseMoves(client, pokeapi.Pokemon{Name: "magikarp"}, 5)
+	assert.NoError(t, err)
+	assert.Len(t, moves, 1)
+	assert.Equal(t, "struggle", moves[0].Name)
+}
+
+func TestBattle_TypeEffectiveness(t *testing.T) {
+	client := newTestClient(t)
+	pikachu := newTestBattler(t, client, "pikachu", 50)
+	gible := newTestBattler(t, client, "gible", 50)
+
+	b := New(typechart.New(client), pikachu, gible, 1)
+
+	// Electric moves can't touch a ground type.
+	events, err := b.PlayTurn(0) // thunder-shock
+	assert.NoError(t, err)
+	for _, e := range events {
+		if e.Attacker == "pikachu" {
+			assert.Equal(t, 0.0, e.Effectiveness)
+			assert.Equal(t, 0, e.Damage)
+		}
+	}
+	assert.Equal(t, gible.Stats.HP, gible.HP)
+}
+
+func TestBattle_SpeedDecidesTurnOrder(t *testing.T) {
+	client := newTestClient(t)
+	pikachu := newTestBattler(t, client, "pikachu", 50)
+	gible := newTestBattler(t, client, "gible", 50)
+
+	b := New(typechart.New(client), gible, pikachu, 1)
+	events, err := b.PlayTurn(0)
+	assert.NoError(t, err)
+	assert.Equal(t, "pikachu", events[0].Attacker)
+}
+
+func TestBattle_PriorityBeatsSpeed(t *testing.T) {
+	client := newTestClient(t)
+	pikachu := newTestBattler(t, client, "pikachu", 50)
+	mewtwo := newTestBattler(t, client, "mewtwo", 50)
+
+	b := New(typechart.New(client), pikachu, mewtwo, 1)
+	events, err := b.PlayTurn(1) // quick-attack
+	assert.NoError(t, err)
+	assert.Equal(t, "pikachu", events[0].Attacker)
+}
+
+func TestBattle_SameSeedReplaysFight(t *testing.T) {
+	client := newTestClient(t)
+
+	fight := func(seed int64) []Event {
+		b := New(typechart.New(client),
+			newTestBattler(t, client, "charmander", 30),
+			newTestBattler(t, client, "bulbasaur", 30),
+			seed)
+		var all []Event
+		for !b.Over() {
+			events, err := b.PlayTurn(1)
+			assert.NoError(t, err)
+			all = append(all, events...)
+		}
+		return all
+	}
+
+	first := fight(7)
+	assert.Equal(t, first, fight(7))
+	assert.NotEmpty(t, first)
+}
+
+func TestBattle_EndsWithWinner(t *testing.T) {
+	client := newTestClient(t)
+	mewtwo := newTestBattler(t, client, "mewtwo", 100)
+	squirtle := newTestBattler(t, client, "squirtle", 5)
+
+	b := New(typechart.New(client), mewtwo, squirtle, 3)
+	assert.Nil(t, b.Winner())
+	for !b.Over() {
+		_, err := b.PlayTurn(0)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, mewtwo, b.Winner())
+
+	_, err := b.PlayTurn(0)
+	assert.Error(t, err)
+}
+
+func TestBattle_InvalidMove(t *testing.T) {
+	client := newTestClient(t)
+	b := New(typechart.New(client), newTestBattler(t, client, "pikachu", 50), newTestBattler(t, client, "gible", 50), 1)
+
+	_, err := b.PlayTurn(9)
+	assert.ErrorContains(t, err, "move must be between 1 and 2")
+}
+
+func TestEvent_String(t *testing.T) {
+	cases := []struct {
+		event    Event
+		expected string
+	}{
+		{Event{Attacker: "pikachu", Move: "thunderbolt", Missed: true}, "pikachu used thunderbolt! But it missed!"},
+		{Event{Attacker: "pikachu", Defender: "gible", Move: "thunderbolt"}, "pikachu used thunderbolt! It doesn't affect gible..."},
+		{
+			Event{Attacker: "gible", Defender: "pikachu", Move: "earthquake", Damage: 80, Effectiveness: 2, Fainted: true},
+			"gible used earthquake! It's super effective! pikachu took 80 damage. pikachu fainted!",
+		},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, c.event.String())
+	}
+}
@@ -0,0 +1,69 @@
+package battle
+
+import (
+	"fmt"
+	"strings"
+)
+
+// critChance is the 1-in-n chance of a critical hit.
+const critChance = 24
+
+// Damage applies the mainline damage formula. stab, effectiveness and
+// random (0.85 to 1.0) are multipliers; a critical hit adds 1.5x. Any hit
+// that is not fully resisted deals at least 1 damage.
+func Damage(level, power, attack, defense int, stab, effectiveness float64, critical bool, random float64) int {
+	if effectiveness == 0 {
+		return 0
+	}
+
+	base := (2*level/5+2)*power*attack/max(1, defense)/50 + 2
+
+	modifier := stab * effectiveness * random
+	if critical {
+		modifier *= 1.5
+	}
+	return max(1, int(float64(base)*modifier))
+}
+
+// Event is the outcome of one attack.
+type Event struct {
+	Attacker      string
+	Defender      string
+	Move          string
+	Damage        int
+	Effectiveness float64
+	Critical      bool
+	Missed        bool
+	Fainted       bool
+}
+
+func (e Event) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s used %s!", e.Attacker, e.Move)
+
+	switch {
+	case e.Missed:
+		b.WriteString(" But it missed!")
+		return b.String()
+	case e.Effectiveness == 0:
+		fmt.Fprintf(&b, " It doesn't affect %s...", e.Defender)
+		return b.String()
+	case e.Damage == 0:
+		b.WriteString(" But nothing happened.")
+		return b.String()
+	}
+
+	if e.Critical {
+		b.WriteString(" A critical hit!")
+	}
+	if e.Effectiveness > 1 {
+		b.WriteString(" It's super effective!")
+	} else if e.Effectiveness < 1 {
+		b.WriteString(" It's not very effective...")
+	}
+	fmt.Fprintf(&b, " %s took %d damage.", e.Defender, e.Damage)
+	if e.Fainted {
+		fmt.Fprintf(&b, " %s fainted!", e.Defender)
+	}
+	return b.String()
+}
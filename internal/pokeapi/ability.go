@@ -0,0 +1,33 @@
+package pokeapi
+
+// AbilityPokemon is a Pokemon that can have an ability, either as one of
+// its regular abilities or as its hidden one.
+type AbilityPokemon struct {
+	IsHidden bool             `json:"is_hidden"`
+	Slot     int              `json:"slot"`
+	Pokemon  NamedAPIResource `json:"pokemon"`
+}
+
+type Ability struct {
+	Name          string           `json:"name"`
+	EffectEntries []VerboseEffect  `json:"effect_entries"`
+	Pokemon       []AbilityPokemon `json:"pokemon"`
+}
+
+func (c *Client) GetAbility(name string) (Ability, error) {
+	url := c.PokeapiBaseURL + "ability/" + name
+	var ability Ability
+
+	err := c.fetchAndCache(url, &ability)
+	if err != nil {
+		return Ability{}, err
+	}
+
+	return ability, nil
+}
+
+// EffectText returns the ability's short effect in the given language, or
+// "" if PokeAPI has none.
+func (a Ability) EffectText(language string) string {
+	return effectText(a.EffectEntries, language)
+}
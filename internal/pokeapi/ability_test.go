@@ -0,0 +1,25 @@
+package pokeapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAbility(t *testing.T) {
+	client, _ := newTestClient(t)
+
+	ability, err := client.GetAbility("lightning-rod")
+	assert.NoError(t, err)
+	assert.Equal(t, "Redirects single-target electric moves to this Pokemon.", ability.EffectText("en"))
+
+	var hidden []string
+	for _, p := range ability.Pokemon {
+		assert.True(t, p.IsHidden)
+		hidden = append(hidden, p.Pokemon.Name)
+	}
+	assert.ElementsMatch(t, []string{"pikachu", "pichu", "raichu"}, hidden)
+
+	_, err = client.GetAbility("wonder-guard")
+	assert.Error(t, err)
+}
@@ -0,0 +1,109 @@
+package pokeapi
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+type Ball struct {
+	Item       string
+	Name       string
+	Multiplier float64
+}
+
+var Balls = []Ball{
+	{Item: "poke-ball", Name: "Poke Ball", Multiplier: 1},
+	{Item: "great-ball", Name: "Great Ball", Multiplier: 1.5},
+	{Item: "ultra-ball", Name: "Ultra Ball", Multiplier: 2},
+	{Item: "master-ball", Name: "Master Ball", Multiplier: 255},
+}
+
+var statusMultipliers = map[string]float64{
+	"":          1,
+	"none":      1,
+	"sleep":     2,
+	"freeze":    2,
+	"paralysis": 1.5,
+	"poison":    1.5,
+	"burn":      1.5,
+}
+
+// CatchOptions describes how a catch is attempted. The zero value throws a
+// Poke Ball at a healthy Pokemon with no status condition.
+type CatchOptions struct {
+	Ball   string
+	Status string
+	// HPPercent is the wild Pokemon's remaining HP from 1 to 100, full
+	// health if zero.
+	HPPercent int
+	// Location is recorded on the caught Pokemon.
+	Location string
+	// Level is the wild Pokemon's level, DefaultCatchLevel if zero.
+	Level int
+}
+
+type CatchResult struct {
+	Pokemon     Pokemon
+	Ball        Ball
+	Probability float64
+	Caught      bool
+	Reward      int
+	// Entry and Place are set when the Pokemon was caught.
+	Entry *CaughtPokemon
+	Place Place
+}
+
+// ParseBall accepts a ball's short name ("ultra") or item name
+// ("ultra-ball"). An empty name is a Poke Ball.
+func ParseBall(name string) (Ball, error) {
+	if name == "" {
+		return Balls[0], nil
+	}
+	for _, ball := range Balls {
+		if name == ball.Item || name+"-ball" == ball.Item {
+			return ball, nil
+		}
+	}
+	return Ball{}, fmt.Errorf("unknown ball: %s", name)
+}
+
+// catchRolls is how many equally likely rolls a catch is decided by; a
+// catch succeeds on the first catchChance*catchRolls of them.
+const catchRolls = 1000
+
+// CheckHPPercent reports whether hpPercent is a remaining HP percentage a
+// catch can be attempted at.
+func CheckHPPercent(hpPercent int) error {
+	if hpPercent < 1 || hpPercent > 100 {
+		return fmt.Errorf("hp must be between 1 and 100 percent, got %d", hpPercent)
+	}
+	return nil
+}
+
+// calculateCatchChance returns the probability of a catch using the
+// generation III/IV formula: a modified catch rate is derived from the
+// species capture rate, remaining HP, ball and status, and the Pokemon must
+// then break free of none of four shake checks.
+func calculateCatchChance(captureRate int, ball Ball, status string, hpPercent int) (float64, error) {
+	statusBonus, ok := statusMultipliers[strings.ToLower(status)]
+	if !ok {
+		return 0, fmt.Errorf("unknown status: %s", status)
+	}
+	if err := CheckHPPercent(hpPercent); err != nil {
+		return 0, err
+	}
+
+	maxHP := 100.0
+	currentHP := float64(hpPercent)
+	a := math.Floor((3*maxHP-2*currentHP)*float64(captureRate)*ball.Multiplier/(3*maxHP)) * statusBonus
+	if a >= 255 {
+		return 1, nil
+	}
+	if a <= 0 {
+		return 0, nil
+	}
+
+	b := math.Floor(1048560 / math.Sqrt(math.Sqrt(16711680/a)))
+	return math.Pow(b/65536, 4), nil
+}
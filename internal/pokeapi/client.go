@@ -6,17 +6,56 @@ import (
 	"io"
 	"math/rand"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/danalytis/pokedexcli/internal/pokecache"
 )
 
+// RandSource is the source of randomness used for catching. *rand.Rand
+// satisfies it; tests can substitute a fixed source.
+type RandSource interface {
+	Intn(n int) int
+}
+
 type Client struct {
 	PokeapiBaseURL string
 	Cache          *pokecache.Cache
-	Pokedex        map[string]Pokemon
+	Trainer        *Trainer
+	Rand           RandSource
+	// Language is the PokeAPI language code text is shown in, e.g. "ja".
+	Language string
+	// Version is the game version Pokedex entries are taken from; empty
+	// means the latest.
+	Version string
+
+	// nationalDex is kept for the whole session, as pokedex and progress
+	// need it every time and it outlives the cache.
+	nationalDex *Pokedex
+}
+type NamedAPIResource struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+type APIResource struct {
+	URL string `json:"url"`
+}
+
+// NamedAPIResourceList is a page of any PokeAPI listing endpoint.
+type NamedAPIResourceList struct {
+	Count    int                `json:"count"`
+	Next     *string            `json:"next"`
+	Previous *string            `json:"previous"`
+	Results  []NamedAPIResource `json:"results"`
 }
+
+// Stat is one of a Pokemon's base stats, with the effort values it
+// yields when defeated.
 type Stat struct {
-	BaseStat int `json:"base_stat"`
+	BaseStat int              `json:"base_stat"`
+	Effort   int              `json:"effort"`
+	Stat     NamedAPIResource `json:"stat"`
 }
 
 type Type struct {
@@ -25,13 +64,52 @@ type Type struct {
 	} `json:"type"`
 }
 
+type PokemonMove struct {
+	Move                NamedAPIResource    `json:"move"`
+	VersionGroupDetails []MoveVersionDetail `json:"version_group_details"`
+}
+
+type PokemonAbility struct {
+	IsHidden bool             `json:"is_hidden"`
+	Slot     int              `json:"slot"`
+	Ability  NamedAPIResource `json:"ability"`
+}
+
+// PokemonHeldItem is an item a wild Pokemon may be holding, with its
+// chance in each game version.
+type PokemonHeldItem struct {
+	Item           NamedAPIResource      `json:"item"`
+	VersionDetails []HeldItemVersionRate `json:"version_details"`
+}
+
+type HeldItemVersionRate struct {
+	Version NamedAPIResource `json:"version"`
+	Rarity  int              `json:"rarity"`
+}
+
 type Pokemon struct {
-	Name           string `json:"name"`
-	BaseExperience int    `json:"base_experience"`
-	Height         int    `json:"height"`
-	Weight         int    `json:"weight"`
-	Stats          []Stat `json:"stats"`
-	Types          []Type `json:"types"`
+	ID             int               `json:"id"`
+	Name           string            `json:"name"`
+	Order          int               `json:"order,omitempty"`
+	BaseExperience int               `json:"base_experience"`
+	Height         int               `json:"height"`
+	Weight         int               `json:"weight"`
+	Species        NamedAPIResource  `json:"species"`
+	Abilities      []PokemonAbility  `json:"abilities,omitempty"`
+	Stats          []Stat            `json:"stats"`
+	Types          []Type            `json:"types"`
+	Moves          []PokemonMove     `json:"moves,omitempty"`
+	HeldItems      []PokemonHeldItem `json:"held_items,omitempty"`
+	Sprites        Sprites           `json:"sprites,omitzero"`
+}
+
+// Sprites are image URLs for a Pokemon. PokeAPI reports missing images as
+// null.
+type Sprites struct {
+	FrontDefault *string `json:"front_default"`
+	FrontShiny   *string `json:"front_shiny"`
+	BackDefault  *string `json:"back_default"`
+	BackShiny    *string `json:"back_shiny"`
 }
 
 type LocationPokemon struct {
@@ -40,11 +118,26 @@ type LocationPokemon struct {
 }
 
 type LocationPokemonEncounter struct {
-	Pokemon LocationPokemon `json:"pokemon"`
+	Pokemon        LocationPokemon          `json:"pokemon"`
+	VersionDetails []VersionEncounterDetail `json:"version_details"`
 }
 type ExploreLocationResponse struct {
+	Name              string                     `json:"name"`
+	Location          NamedAPIResource           `json:"location"`
 	PokemonEncounters []LocationPokemonEncounter `json:"pokemon_encounters"`
 }
+
+// LocationAreaEncounter is where and how a Pokemon can be found in one
+// location area.
+type LocationAreaEncounter struct {
+	LocationArea   NamedAPIResource         `json:"location_area"`
+	VersionDetails []VersionEncounterDetail `json:"version_details"`
+}
+
+// PokemonEncountersResponse lists every location area a Pokemon can be
+// found in, from /pokemon/{name}/encounters.
+type PokemonEncountersResponse []LocationAreaEncounter
+
 type LocationAreasResponse struct {
 	Count    int            `json:"count"`
 	Next     *string        `json:"next"`
@@ -61,76 +154,180 @@ func NewClient(cache *pokecache.Cache) *Client {
 	return &Client{
 		PokeapiBaseURL: "https://pokeapi.co/api/v2/",
 		Cache:          cache,
-		Pokedex:        make(map[string]Pokemon),
+		Trainer:        NewTrainer(),
+		Rand:           NewRand(time.Now().UnixNano()),
+		Language:       DefaultLanguage,
 	}
 }
 
+// NewRand returns a RandSource seeded with seed. The same seed always
+// produces the same sequence of catch outcomes.
+func NewRand(seed int64) RandSource {
+	return rand.New(rand.NewSource(seed))
+}
+
 func (c *Client) fetchAndCache(url string, target interface{}) error {
-	result, ok := c.Cache.Get(url)
-	if !ok {
-		// Cache miss - make HTTP request
-		res, err := http.Get(url)
-		if err != nil {
-			return fmt.Errorf("error making request: %w", err)
-		}
-		defer res.Body.Close()
+	body, err := c.fetch(url)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, target)
+}
 
-		if res.StatusCode > 299 {
-			return fmt.Errorf("response failed with status code: %d", res.StatusCode)
-		}
+// fetch returns the body at url, from the cache if it has been fetched
+// before.
+func (c *Client) fetch(url string) ([]byte, error) {
+	if result, ok := c.Cache.Get(url); ok {
+		return result, nil
+	}
 
-		body, err := io.ReadAll(res.Body)
-		if err != nil {
-			return fmt.Errorf("error reading response body: %w", err)
-		}
+	res, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer res.Body.Close()
 
-		c.Cache.Add(url, body)
-		return json.Unmarshal(body, target)
-	} else {
-		// Cache hit - unmarshal from cache
-		return json.Unmarshal(result, target)
+	if res.StatusCode > 299 {
+		return nil, fmt.Errorf("response failed with status code: %d", res.StatusCode)
 	}
-}
 
-func calculateCatchChance(baseExperience int) int {
-	maxCatchChance := 60
-	catchChance := maxCatchChance - (baseExperience / 10)
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
 
-	return max(5, catchChance)
+	c.Cache.Add(url, body)
+	return body, nil
 }
 
-func (c *Client) CatchPokemon(name string) (bool, error) {
+func (c *Client) GetPokemon(name string) (Pokemon, error) {
 	url := c.PokeapiBaseURL + "pokemon/" + name
 	var pokemon Pokemon
 
 	err := c.fetchAndCache(url, &pokemon)
 	if err != nil {
-		return false, err
+		return Pokemon{}, err
+	}
+
+	return pokemon, nil
+}
+
+// FullPokemon returns all of a caught Pokemon's PokeAPI data, fetching what
+// was left out of the save file it was loaded from.
+func (c *Client) FullPokemon(cp *CaughtPokemon) (Pokemon, error) {
+	if cp.Pokemon.Moves != nil {
+		return cp.Pokemon, nil
+	}
+	name := cp.Pokemon.Name
+	if name == "" {
+		name = cp.Species
+	}
+	pokemon, err := c.GetPokemon(name)
+	if err != nil {
+		return Pokemon{}, err
+	}
+	cp.Pokemon = pokemon
+	return pokemon, nil
+}
+
+func (c *Client) CatchPokemon(name string, opts CatchOptions) (CatchResult, error) {
+	ball, err := ParseBall(opts.Ball)
+	if err != nil {
+		return CatchResult{}, err
+	}
+	if c.Trainer.Items[ball.Item] <= 0 {
+		return CatchResult{}, fmt.Errorf("you have no %ss left", ball.Name)
+	}
+	if !c.Trainer.HasRoom() {
+		return CatchResult{}, ErrCollectionFull
+	}
+
+	pokemon, err := c.GetPokemon(name)
+	if err != nil {
+		return CatchResult{}, err
 	}
 
-	catchChance := calculateCatchChance(pokemon.BaseExperience)
-	randomRoll := rand.Intn(100)
+	species, err := c.GetPokemonSpecies(pokemon.SpeciesName())
+	if err != nil {
+		return CatchResult{}, err
+	}
 
-	if randomRoll < catchChance {
-		c.Pokedex[name] = pokemon
+	hpPercent := opts.HPPercent
+	if hpPercent == 0 {
+		hpPercent = 100
+	}
+	catchChance, err := calculateCatchChance(species.CaptureRate, ball, opts.Status, hpPercent)
+	if err != nil {
+		return CatchResult{}, err
 	}
 
-	return randomRoll < catchChance, nil
+	level := opts.Level
+	if level == 0 {
+		level = DefaultCatchLevel
+	}
+	if level < 1 || level > MaxLevel {
+		return CatchResult{}, fmt.Errorf("level must be between 1 and %d", MaxLevel)
+	}
+	var rate GrowthRate
+	if species.GrowthRate.Name != "" {
+		rate, err = c.GetGrowthRate(species.GrowthRate.Name)
+		if err != nil {
+			return CatchResult{}, err
+		}
+	}
+
+	if err := c.Trainer.UseItem(ball.Item); err != nil {
+		return CatchResult{}, err
+	}
+
+	result := CatchResult{
+		Pokemon:     pokemon,
+		Ball:        ball,
+		Probability: catchChance,
+		Caught:      c.Rand.Intn(catchRolls) < int(catchChance*catchRolls),
+	}
+	if result.Caught {
+		result.Entry, result.Place, err = c.Trainer.AddCaught(pokemon, opts.Location, time.Now())
+		if err != nil {
+			return CatchResult{}, err
+		}
+		result.Entry.Level = level
+		result.Entry.Experience = rate.ExperienceAt(level)
+		result.Entry.GrowthRate = rate.Name
+		result.Entry.IVs = c.rollIVs()
+		result.Entry.Nature = c.rollNature()
+		result.Entry.Friendship = species.BaseHappiness
+		result.Entry.HeldItem = c.rollHeldItem(pokemon)
+		result.Reward = pokemon.BaseExperience * catchRewardPerExp
+		c.Trainer.Money += result.Reward
+	} else {
+		c.Trainer.See(pokemon.SpeciesName())
+	}
+
+	return result, nil
 }
 
 func (c *Client) InspectPokemon(name string) (bool, error) {
 
-	statNames := []string{"hp", "attack", "defense", "special-attack", "special-defense", "speed"}
-
-	_, ok := c.Pokedex[name]
+	caught, ok := c.Trainer.Lookup(name)
+	if !ok {
+		if err := c.Trainer.ambiguousTag(name); err != nil {
+			return false, err
+		}
+	}
 
+	if !ok {
+		if species, err := c.speciesOf(name); err == nil && c.Trainer.Status(species) == DexSeen {
+			return false, c.inspectSeen(name)
+		}
+	}
 	if !ok {
 		fmt.Println("You have not caught this pokemon yet..")
 		fmt.Printf("Name: %s\nHeight: ??\nWeight: ??\n", name)
 
 		fmt.Println("Stats:")
-		for i, _ := range statNames {
-			fmt.Printf(" - %s: ??\n", statNames[i])
+		for _, statName := range StatNames {
+			fmt.Printf(" - %s: ??\n", statName)
 		}
 		fmt.Println("Types:")
 		typeName := "??"
@@ -138,23 +335,126 @@ func (c *Client) InspectPokemon(name string) (bool, error) {
 		return false, nil
 	}
 
-	fmt.Printf("Name: %s\nHeight: %d\nWeight: %d\n",
-		c.Pokedex[name].Name,
-		c.Pokedex[name].Height,
-		c.Pokedex[name].Weight)
-
-	fmt.Println("Stats:")
-	for i, val := range c.Pokedex[name].Stats {
-		fmt.Printf(" - %s: %d\n", statNames[i], val.BaseStat)
+	pokemon := caught.Pokemon
+	// The abilities and held items aren't saved; without a connection the
+	// rest is still worth showing.
+	if full, err := c.FullPokemon(caught); err == nil {
+		pokemon = full
+	}
+	fmt.Printf("ID: %d\nName: %s\nHeight: %d\nWeight: %d\n",
+		caught.ID,
+		pokemon.Name,
+		pokemon.Height,
+		pokemon.Weight)
+	if pokemon.ID != 0 {
+		fmt.Printf("National No.: %d\n", pokemon.ID)
+	}
+	if caught.Nickname != "" {
+		fmt.Printf("Nickname: %s\n", caught.Nickname)
+	}
+	if len(caught.Tags) > 0 {
+		fmt.Printf("Tags: %s\n", strings.Join(caught.Tags, ", "))
+	}
+	if caught.Notes != "" {
+		fmt.Printf("Notes: %s\n", caught.Notes)
+	}
+	c.printSpeciesInfo(pokemon.SpeciesName())
+	fmt.Printf("Level: %d\nExperience: %d\nNature: %s\nFriendship: %d\n",
+		caught.Level,
+		caught.Experience,
+		caught.Nature,
+		caught.Friendship)
+	fmt.Printf("HP: %d/%d\n", caught.HP(), caught.Stats().HP)
+
+	base, stats := BaseStats(pokemon), caught.Stats()
+	fmt.Println("Stats (base -> actual, IV/EV):")
+	for _, statName := range StatNames {
+		fmt.Printf(" - %s: %d -> %d (%d/%d)\n", statName,
+			base.Get(statName),
+			stats.Get(statName),
+			caught.IVs.Get(statName),
+			caught.EVs.Get(statName))
 	}
 	fmt.Println("Types:")
-	for _, typeName := range c.Pokedex[name].Types {
+	for _, typeName := range pokemon.Types {
 		fmt.Printf(" - %s\n", typeName.Type.Name)
 	}
+	if caught.HeldItem != "" {
+		fmt.Printf("Held item: %s\n", caught.HeldItem)
+	}
+	if len(pokemon.Abilities) > 0 {
+		fmt.Println("Abilities:")
+		for _, a := range pokemon.Abilities {
+			hidden := ""
+			if a.IsHidden {
+				hidden = " (hidden)"
+			}
+			fmt.Printf(" - %s%s\n", a.Ability.Name, hidden)
+		}
+	}
+	if len(pokemon.HeldItems) > 0 {
+		fmt.Println("May hold:")
+		for _, h := range pokemon.HeldItems {
+			fmt.Printf(" - %s\n", h.Item.Name)
+		}
+	}
 
 	return true, nil
 }
 
+// printSpeciesInfo shows the species' name, genus and Pokedex entry in the
+// client's language. It is best-effort, as the rest of inspect works from
+// saved data without the network.
+func (c *Client) printSpeciesInfo(name string) {
+	species, err := c.GetPokemonSpecies(name)
+	if err != nil {
+		fmt.Printf("Species details unavailable: %v\n", err)
+		return
+	}
+	if local := species.LocalizedName(c.Language); !strings.EqualFold(local, name) {
+		fmt.Printf("Name (%s): %s\n", c.Language, local)
+	}
+	if genus := species.GenusIn(c.Language); genus != "" {
+		fmt.Printf("Genus: %s\n", genus)
+	}
+	if text, version := species.FlavorTextIn(c.Language, c.Version); text != "" {
+		fmt.Printf("Pokedex entry (%s): %s\n", version, text)
+	}
+}
+
+// inspectSeen shows what a trainer learns from seeing a Pokemon without
+// catching it: what it looks like and its types, but not its stats.
+func (c *Client) inspectSeen(name string) error {
+	pokemon, err := c.GetPokemon(name)
+	if err != nil {
+		return err
+	}
+
+	species, err := c.GetPokemonSpecies(pokemon.SpeciesName())
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("You have seen this pokemon but not caught it yet..")
+	fmt.Printf("Name: %s\n", pokemon.Name)
+	if local := species.LocalizedName(c.Language); !strings.EqualFold(local, pokemon.Name) {
+		fmt.Printf("Name (%s): %s\n", c.Language, local)
+	}
+	fmt.Printf("Height: ??\nWeight: ??\n")
+	if pokemon.Sprites.FrontDefault != nil {
+		fmt.Printf("Sprite: %s\n", *pokemon.Sprites.FrontDefault)
+	}
+	fmt.Println("Stats:")
+	for _, statName := range StatNames {
+		fmt.Printf(" - %s: ??\n", statName)
+	}
+	fmt.Println("Types:")
+	for _, typeName := range pokemon.Types {
+		fmt.Printf(" - %s\n", typeName.Type.Name)
+	}
+	return nil
+}
+
 func (c *Client) ExploreLocation(name string) (ExploreLocationResponse, error) {
 	url := c.PokeapiBaseURL + "location-area/" + name
 	var exploreLocationResp ExploreLocationResponse
@@ -167,6 +467,18 @@ func (c *Client) ExploreLocation(name string) (ExploreLocationResponse, error) {
 	return exploreLocationResp, nil
 }
 
+func (c *Client) GetPokemonEncounters(name string) (PokemonEncountersResponse, error) {
+	url := c.PokeapiBaseURL + "pokemon/" + name + "/encounters"
+	var encounters PokemonEncountersResponse
+
+	err := c.fetchAndCache(url, &encounters)
+	if err != nil {
+		return nil, err
+	}
+
+	return encounters, nil
+}
+
 func (c *Client) GetLocationAreas(url string) (LocationAreasResponse, error) {
 	var locationAreasResp LocationAreasResponse
 
@@ -178,10 +490,23 @@ func (c *Client) GetLocationAreas(url string) (LocationAreasResponse, error) {
 	return locationAreasResp, nil
 }
 
+func (c *Client) GetResourceList(url string) (NamedAPIResourceList, error) {
+	var list NamedAPIResourceList
+
+	err := c.fetchAndCache(url, &list)
+	if err != nil {
+		return NamedAPIResourceList{}, err
+	}
+
+	return list, nil
+}
+
 func NewClientWithBaseURL(cache *pokecache.Cache, baseURL string) *Client {
 	return &Client{
 		PokeapiBaseURL: baseURL,
 		Cache:          cache,
-		Pokedex:        make(map[string]Pokemon),
+		Trainer:        NewTrainer(),
+		Rand:           NewRand(time.Now().UnixNano()),
+		Language:       DefaultLanguage,
 	}
 }
@@ -7,10 +7,20 @@ import (
 	"testing"
 	"time"
 
+	"github.com/danalytis/pokedexcli/internal/pokeapitest"
 	"github.com/danalytis/pokedexcli/internal/pokecache"
 	"github.com/stretchr/testify/assert"
 )
 
+// fixedRand is a RandSource that always returns the same roll.
+type fixedRand struct {
+	roll int
+}
+
+func (r fixedRand) Intn(n int) int {
+	return r.roll % n
+}
+
 func TestFetchAndCache_CacheHit(t *testing.T) {
 	cache := pokecache.NewCache(5 * time.Minute)
 	client := NewClient(&cache)
@@ -121,10 +131,10 @@ func TestCatchPokemon_Success(t *testing.T) {
 	cache := pokecache.NewCache(5 * time.Minute)
 	client := NewClientWithBaseURL(&cache, server.URL+"/")
 
-	caught, err := client.CatchPokemon("pikachu")
+	caught, err := client.CatchPokemon("pikachu", CatchOptions{})
 
 	assert.NoError(t, err)
-	assert.IsType(t, true, caught)
+	assert.IsType(t, true, caught.Caught)
 }
 
 func TestCatchPokemon_PokemonNotFound(t *testing.T) {
@@ -136,7 +146,7 @@ func TestCatchPokemon_PokemonNotFound(t *testing.T) {
 	cache := pokecache.NewCache(5 * time.Minute)
 	client := NewClientWithBaseURL(&cache, server.URL+"/")
 
-	_, err := client.CatchPokemon("fakemon")
+	_, err := client.CatchPokemon("fakemon", CatchOptions{})
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "response failed with status code")
 }
@@ -227,12 +237,12 @@ func TestInspectPokemon_PokemonInPokedex(t *testing.T) {
 	cache := pokecache.NewCache(5 * time.Minute)
 	client := NewClient(&cache)
 
-	client.Pokedex["pikachu"] = Pokemon{
+	client.Trainer.AddCaught(Pokemon{
 		Name:           "pikachu",
 		BaseExperience: 112,
 		Height:         4,
 		Weight:         60,
-	}
+	}, "", time.Now())
 
 	result, err := client.InspectPokemon("pikachu")
 	assert.NoError(t, err)
@@ -249,11 +259,11 @@ func TestCatchPokemon_ServerError(t *testing.T) {
 	cache := pokecache.NewCache(5 * time.Minute)
 	client := NewClientWithBaseURL(&cache, server.URL+"/")
 
-	result, err := client.CatchPokemon("pikachu")
+	result, err := client.CatchPokemon("pikachu", CatchOptions{})
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "500")
-	assert.Equal(t, false, result)
+	assert.False(t, result.Caught)
 
 }
 
@@ -278,10 +288,10 @@ func TestCatchPokemon_InvalidJSON(t *testing.T) {
 			cache := pokecache.NewCache(5 * time.Minute)
 			client := NewClientWithBaseURL(&cache, server.URL+"/")
 
-			result, err := client.CatchPokemon("pikachu")
+			result, err := client.CatchPokemon("pikachu", CatchOptions{})
 
 			assert.Error(t, err)
-			assert.Equal(t, false, result)
+			assert.False(t, result.Caught)
 
 		})
 	}
@@ -293,6 +303,7 @@ func TestCatchPokemon_SuccessfulCatch_ChecksPokedex(t *testing.T) {
 		fmt.Fprintln(w, `{
 			"name": "pikachu",
 			"base_experience": 0,
+			"capture_rate": 255,
 			"height": 4,
 			"weight": 60,
 			"stats": [{"base_stat": 35}],
@@ -304,23 +315,25 @@ func TestCatchPokemon_SuccessfulCatch_ChecksPokedex(t *testing.T) {
 	cache := pokecache.NewCache(5 * time.Minute)
 	client := NewClientWithBaseURL(&cache, server.URL+"/")
 
-	var caught bool
+	client.Rand = fixedRand{roll: 0}
+
+	var caught CatchResult
 	var err error
 	for i := 0; i < 20; i++ {
-		caught, err = client.CatchPokemon("pikachu")
-		if caught {
+		caught, err = client.CatchPokemon("pikachu", CatchOptions{})
+		if caught.Caught {
 			break
 		}
 	}
 
-	assert.True(t, caught)
+	assert.True(t, caught.Caught)
 	assert.NoError(t, err)
 
 	result, err := client.InspectPokemon("pikachu")
 	assert.NoError(t, err)
 	assert.True(t, result)
 
-	_, inPokedex := client.Pokedex["pikachu"]
+	_, inPokedex := client.Trainer.Lookup("pikachu")
 	assert.True(t, inPokedex)
 }
 
@@ -340,16 +353,17 @@ func TestCatchPokemon_FailedCatch_InspectShowsNotCaught(t *testing.T) {
 
 	cache := pokecache.NewCache(5 * time.Minute)
 	client := NewClientWithBaseURL(&cache, server.URL+"/")
+	client.Rand = fixedRand{roll: 999}
 
-	caught, err := client.CatchPokemon("pikachu")
-	assert.False(t, caught)
+	caught, err := client.CatchPokemon("pikachu", CatchOptions{})
+	assert.False(t, caught.Caught)
 	assert.NoError(t, err)
 
 	expectedURL := server.URL + "/pokemon/pikachu"
 	_, exists := cache.Get(expectedURL)
 	assert.True(t, exists)
 
-	_, inPokedex := client.Pokedex["pikachu"]
+	_, inPokedex := client.Trainer.Lookup("pikachu")
 	assert.False(t, inPokedex)
 
 	result, err := client.InspectPokemon("pikachu")
@@ -373,12 +387,13 @@ func TestCatchPokemon_FailedCatch_CachesDataButNotInPokedex(t *testing.T) {
 
 	cache := pokecache.NewCache(5 * time.Minute)
 	client := NewClientWithBaseURL(&cache, server.URL+"/")
-	caught, err := client.CatchPokemon("pikachu")
+	client.Rand = fixedRand{roll: 999}
+	caught, err := client.CatchPokemon("pikachu", CatchOptions{})
 
-	assert.False(t, caught)
+	assert.False(t, caught.Caught)
 	assert.NoError(t, err)
 
-	_, inPokedex := client.Pokedex["pikachu"]
+	_, inPokedex := client.Trainer.Lookup("pikachu")
 	assert.False(t, inPokedex)
 
 	expectedURL := server.URL + "/pokemon/pikachu"
@@ -405,13 +420,14 @@ func TestCatchPokemon_SecondAttempt_UsesCachedData(t *testing.T) {
 	cache := pokecache.NewCache(5 * time.Minute)
 	client := NewClientWithBaseURL(&cache, server.URL+"/")
 
-	_, err1 := client.CatchPokemon("pikachu")
+	// One request for the Pokemon and one for its species.
+	_, err1 := client.CatchPokemon("pikachu", CatchOptions{})
 	assert.NoError(t, err1)
-	assert.Equal(t, 1, callCount)
+	assert.Equal(t, 2, callCount)
 
-	_, err2 := client.CatchPokemon("pikachu")
+	_, err2 := client.CatchPokemon("pikachu", CatchOptions{})
 	assert.NoError(t, err2)
-	assert.Equal(t, 1, callCount)
+	assert.Equal(t, 2, callCount)
 
 }
 
@@ -485,19 +501,204 @@ func TestClient_CacheIntegration(t *testing.T) {
 
 func TestCatchPokemon_CatchProbabilityCalculation(t *testing.T) {
 	cases := []struct {
-		name           string
-		baseExperience int
-		expectedChance int
+		name        string
+		captureRate int
+		ball        string
+		status      string
+		hpPercent   int
+		expected    float64
 	}{
-		{"normal case", 100, 50},
-		{"minimum clamp", 600, 5},
-		{"low experience", 50, 55},
-		{"zero experience", 0, 60},
+		{"full health poke ball", 45, "poke", "", 100, 0.0588},
+		{"ultra ball", 190, "ultra", "", 100, 0.4941},
+		{"asleep at low health", 45, "poke", "sleep", 1, 0.3451},
+		{"zero capture rate", 0, "ultra", "sleep", 1, 0},
+		{"master ball", 3, "master", "", 100, 1},
+		{"certain catch", 255, "ultra", "sleep", 1, 1},
 	}
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
-			actual := calculateCatchChance(c.baseExperience)
-			assert.Equal(t, c.expectedChance, actual)
+			ball, err := ParseBall(c.ball)
+			assert.NoError(t, err)
+
+			actual, err := calculateCatchChance(c.captureRate, ball, c.status, c.hpPercent)
+			assert.NoError(t, err)
+			assert.InDelta(t, c.expected, actual, 0.001)
 		})
 	}
 }
+
+func TestCatchPokemon_CatchChanceModifiers(t *testing.T) {
+	base, _ := calculateCatchChance(45, Balls[0], "", 100)
+	great, _ := calculateCatchChance(45, Balls[1], "", 100)
+	ultra, _ := calculateCatchChance(45, Balls[2], "", 100)
+	weakened, _ := calculateCatchChance(45, Balls[0], "", 10)
+	asleep, _ := calculateCatchChance(45, Balls[0], "sleep", 100)
+	paralyzed, _ := calculateCatchChance(45, Balls[0], "paralysis", 100)
+
+	assert.Less(t, base, great)
+	assert.Less(t, great, ultra)
+	assert.Less(t, base, weakened)
+	assert.Less(t, paralyzed, asleep)
+	assert.Less(t, base, paralyzed)
+}
+
+func TestCatchPokemon_InvalidOptions(t *testing.T) {
+	server := pokeapitest.NewServer()
+	defer server.Close()
+
+	cache := pokecache.NewCache(5 * time.Minute)
+	client := NewClientWithBaseURL(&cache, server.BaseURL())
+
+	_, err := client.CatchPokemon("pikachu", CatchOptions{Ball: "premier"})
+	assert.ErrorContains(t, err, "unknown ball")
+
+	_, err = client.CatchPokemon("pikachu", CatchOptions{Status: "confused"})
+	assert.ErrorContains(t, err, "unknown status")
+
+	for _, hp := range []int{-5, 101} {
+		_, err = client.CatchPokemon("pikachu", CatchOptions{HPPercent: hp})
+		assert.ErrorContains(t, err, "hp must be between 1 and 100")
+	}
+	assert.Equal(t, 10, client.Trainer.Items["poke-ball"])
+}
+
+func TestParseBall(t *testing.T) {
+	cases := []struct {
+		input string
+		item  string
+	}{
+		{"", "poke-ball"},
+		{"poke", "poke-ball"},
+		{"great-ball", "great-ball"},
+		{"ultra", "ultra-ball"},
+		{"master", "master-ball"},
+	}
+	for _, c := range cases {
+		t.Run(c.input, func(t *testing.T) {
+			ball, err := ParseBall(c.input)
+			assert.NoError(t, err)
+			assert.Equal(t, c.item, ball.Item)
+		})
+	}
+}
+
+func TestGetLocationAreas_FakeServerPagination(t *testing.T) {
+	server := pokeapitest.NewServer()
+	defer server.Close()
+
+	cache := pokecache.NewCache(5 * time.Minute)
+	client := NewClientWithBaseURL(&cache, server.BaseURL())
+
+	first, err := client.GetLocationAreas(server.BaseURL() + "location-area")
+	assert.NoError(t, err)
+	assert.Equal(t, len(pokeapitest.SeedLocationAreas), first.Count)
+	assert.Len(t, first.Results, 20)
+	assert.Nil(t, first.Previous)
+	assert.NotNil(t, first.Next)
+
+	second, err := client.GetLocationAreas(*first.Next)
+	assert.NoError(t, err)
+	assert.Nil(t, second.Next)
+	assert.NotNil(t, second.Previous)
+
+	back, err := client.GetLocationAreas(*second.Previous)
+	assert.NoError(t, err)
+	assert.Equal(t, first.Results, back.Results)
+}
+
+func TestExploreLocation_FakeServerErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		inject func(*pokeapitest.Server)
+		errMsg string
+	}{
+		{"not found", func(s *pokeapitest.Server) { s.FailWith("location-area/canalave-city-area", 404) }, "404"},
+		{"server error", func(s *pokeapitest.Server) { s.FailWith("location-area/canalave-city-area", 500) }, "500"},
+		{"malformed json", func(s *pokeapitest.Server) { s.ServeMalformed("location-area/canalave-city-area") }, "invalid character"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			server := pokeapitest.NewServer()
+			defer server.Close()
+			c.inject(server)
+
+			cache := pokecache.NewCache(5 * time.Minute)
+			client := NewClientWithBaseURL(&cache, server.BaseURL())
+
+			_, err := client.ExploreLocation("canalave-city-area")
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), c.errMsg)
+		})
+	}
+}
+
+func TestCatchPokemon_FakeServerUsesCache(t *testing.T) {
+	server := pokeapitest.NewServer()
+	defer server.Close()
+
+	cache := pokecache.NewCache(5 * time.Minute)
+	client := NewClientWithBaseURL(&cache, server.BaseURL())
+
+	_, err := client.CatchPokemon("pikachu", CatchOptions{})
+	assert.NoError(t, err)
+	_, err = client.CatchPokemon("pikachu", CatchOptions{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, server.Requests("pokemon/pikachu"))
+	assert.Equal(t, 1, server.Requests("pokemon-species/pikachu"))
+}
+
+func TestCatchPokemon_ForcedOutcome(t *testing.T) {
+	cases := []struct {
+		name   string
+		roll   int
+		caught bool
+	}{
+		{"roll below chance", 0, true},
+		{"roll just below chance", 246, true},
+		{"roll just above chance", 247, false},
+		{"roll above chance", 999, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			server := pokeapitest.NewServer()
+			defer server.Close()
+
+			cache := pokecache.NewCache(5 * time.Minute)
+			client := NewClientWithBaseURL(&cache, server.BaseURL())
+			client.Rand = fixedRand{roll: c.roll}
+
+			// pikachu's capture rate of 190 gives a Poke Ball a 24.7% chance.
+			caught, err := client.CatchPokemon("pikachu", CatchOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, c.caught, caught.Caught)
+
+			_, inPokedex := client.Trainer.Lookup("pikachu")
+			assert.Equal(t, c.caught, inPokedex)
+		})
+	}
+}
+
+func TestCatchPokemon_SameSeedReplaysSession(t *testing.T) {
+	server := pokeapitest.NewServer()
+	defer server.Close()
+
+	play := func(seed int64) []bool {
+		cache := pokecache.NewCache(5 * time.Minute)
+		client := NewClientWithBaseURL(&cache, server.BaseURL())
+		client.Rand = NewRand(seed)
+		client.Trainer.Items["poke-ball"] = 20
+
+		var outcomes []bool
+		for i := 0; i < 20; i++ {
+			caught, err := client.CatchPokemon("pikachu", CatchOptions{})
+			assert.NoError(t, err)
+			outcomes = append(outcomes, caught.Caught)
+		}
+		return outcomes
+	}
+
+	assert.Equal(t, play(42), play(42))
+}
@@ -0,0 +1,268 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	PartySize = 6
+	NumBoxes  = 8
+	BoxSize   = 30
+)
+
+var ErrCollectionFull = errors.New("your party and PC boxes are full")
+
+// CaughtPokemon is one individual Pokemon owned by the trainer. Several may
+// share a species.
+type CaughtPokemon struct {
+	ID       int       `json:"id"`
+	Species  string    `json:"species"`
+	CaughtAt time.Time `json:"caught_at"`
+	Location string    `json:"location,omitempty"`
+	Pokemon  Pokemon   `json:"pokemon"`
+
+	Level      int     `json:"level"`
+	Experience int     `json:"experience"`
+	GrowthRate string  `json:"growth_rate,omitempty"`
+	Nature     string  `json:"nature"`
+	IVs        StatSet `json:"ivs"`
+	EVs        StatSet `json:"evs"`
+	Friendship int     `json:"friendship"`
+	// Damage is the HP the Pokemon has lost, so a Pokemon with none is at
+	// full health whatever its level.
+	Damage int `json:"damage,omitempty"`
+	// HeldItem is the item the Pokemon was holding when caught.
+	HeldItem string `json:"held_item,omitempty"`
+
+	// Nickname, Notes and Tags are the trainer's own labels for the
+	// Pokemon.
+	Nickname string   `json:"nickname,omitempty"`
+	Notes    string   `json:"notes,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+}
+
+// MarshalJSON leaves the Pokemon's moves, abilities, held items and sprites
+// out of save files; they make up most of a PokeAPI payload and FullPokemon
+// fetches them again when they are needed.
+func (cp CaughtPokemon) MarshalJSON() ([]byte, error) {
+	type saved CaughtPokemon
+	s := saved(cp)
+	s.Pokemon = Pokemon{
+		ID:             cp.Pokemon.ID,
+		Name:           cp.Pokemon.Name,
+		BaseExperience: cp.Pokemon.BaseExperience,
+		Height:         cp.Pokemon.Height,
+		Weight:         cp.Pokemon.Weight,
+		Species:        cp.Pokemon.Species,
+		Stats:          cp.Pokemon.Stats,
+		Types:          cp.Pokemon.Types,
+	}
+	return json.Marshal(s)
+}
+
+// Place describes where a caught Pokemon is kept. Box is zero for Pokemon
+// in the party and 1-based otherwise.
+type Place struct {
+	Box int
+}
+
+func (p Place) String() string {
+	if p.Box == 0 {
+		return "party"
+	}
+	return fmt.Sprintf("box %d", p.Box)
+}
+
+// AddCaught stores a newly caught Pokemon in the party, or in the first PC
+// box with room once the party is full. It starts at DefaultCatchLevel with
+// a neutral nature; callers fill in anything they know better.
+func (t *Trainer) AddCaught(pokemon Pokemon, location string, caughtAt time.Time) (*CaughtPokemon, Place, error) {
+	t.ensureBoxes()
+
+	t.NextID++
+	t.markCaught(pokemon.SpeciesName())
+	caught := &CaughtPokemon{
+		ID:       t.NextID,
+		Species:  pokemon.SpeciesName(),
+		CaughtAt: caughtAt,
+		Location: location,
+		Pokemon:  pokemon,
+		Level:    DefaultCatchLevel,
+		Nature:   Natures[0].Name,
+	}
+
+	if len(t.Party) < PartySize {
+		t.Party = append(t.Party, caught)
+		return caught, Place{}, nil
+	}
+	for i := range t.Boxes {
+		if len(t.Boxes[i]) < BoxSize {
+			t.Boxes[i] = append(t.Boxes[i], caught)
+			return caught, Place{Box: i + 1}, nil
+		}
+	}
+	t.NextID--
+	return nil, Place{}, ErrCollectionFull
+}
+
+// HasRoom reports whether another Pokemon can be caught.
+func (t *Trainer) HasRoom() bool {
+	t.ensureBoxes()
+	if len(t.Party) < PartySize {
+		return true
+	}
+	for _, box := range t.Boxes {
+		if len(box) < BoxSize {
+			return true
+		}
+	}
+	return false
+}
+
+// Owned returns every caught Pokemon, party first and then each box in
+// order.
+func (t *Trainer) Owned() []*CaughtPokemon {
+	owned := append([]*CaughtPokemon(nil), t.Party...)
+	for _, box := range t.Boxes {
+		owned = append(owned, box...)
+	}
+	return owned
+}
+
+// Find returns the caught Pokemon with the given ID and where it is kept.
+func (t *Trainer) Find(id int) (*CaughtPokemon, Place, bool) {
+	for _, p := range t.Party {
+		if p.ID == id {
+			return p, Place{}, true
+		}
+	}
+	for i, box := range t.Boxes {
+		for _, p := range box {
+			if p.ID == id {
+				return p, Place{Box: i + 1}, true
+			}
+		}
+	}
+	return nil, Place{}, false
+}
+
+// Lookup finds a caught Pokemon by ID or, failing that, the first one of
+// the named species, the one with that nickname or the only one with that
+// tag. A tag shared by several Pokemon matches none of them; see
+// ambiguousTag.
+func (t *Trainer) Lookup(nameOrID string) (*CaughtPokemon, bool) {
+	if id, err := strconv.Atoi(nameOrID); err == nil {
+		p, _, ok := t.Find(id)
+		return p, ok
+	}
+	owned := t.Owned()
+	for _, match := range []func(*CaughtPokemon) bool{
+		func(p *CaughtPokemon) bool { return p.Species == nameOrID },
+		func(p *CaughtPokemon) bool { return strings.EqualFold(p.Nickname, nameOrID) },
+	} {
+		for _, p := range owned {
+			if match(p) {
+				return p, true
+			}
+		}
+	}
+	if tagged := t.Tagged(nameOrID); len(tagged) == 1 {
+		return tagged[0], true
+	}
+	return nil, false
+}
+
+// Deposit moves a party Pokemon into a PC box. A box of zero picks the
+// first box with room. The last Pokemon in the party cannot be deposited.
+func (t *Trainer) Deposit(id, box int) (int, error) {
+	t.ensureBoxes()
+
+	index := indexOf(t.Party, id)
+	if index < 0 {
+		return 0, fmt.Errorf("no Pokemon with ID %d in your party", id)
+	}
+	if len(t.Party) == 1 {
+		return 0, errors.New("you can't deposit your last party Pokemon")
+	}
+
+	if box == 0 {
+		for i := range t.Boxes {
+			if len(t.Boxes[i]) < BoxSize {
+				box = i + 1
+				break
+			}
+		}
+		if box == 0 {
+			return 0, errors.New("all PC boxes are full")
+		}
+	}
+	if box < 1 || box > NumBoxes {
+		return 0, fmt.Errorf("box must be between 1 and %d", NumBoxes)
+	}
+	if len(t.Boxes[box-1]) >= BoxSize {
+		return 0, fmt.Errorf("box %d is full", box)
+	}
+
+	t.Boxes[box-1] = append(t.Boxes[box-1], t.Party[index])
+	t.Party = removeID(t.Party, id)
+	return box, nil
+}
+
+// Withdraw moves a boxed Pokemon into the party.
+func (t *Trainer) Withdraw(id int) error {
+	p, place, ok := t.Find(id)
+	if !ok || place.Box == 0 {
+		return fmt.Errorf("no Pokemon with ID %d in your PC boxes", id)
+	}
+	if len(t.Party) >= PartySize {
+		return fmt.Errorf("your party already has %d Pokemon", PartySize)
+	}
+
+	t.Boxes[place.Box-1] = removeID(t.Boxes[place.Box-1], id)
+	t.Party = append(t.Party, p)
+	return nil
+}
+
+// Release lets a caught Pokemon go for good.
+func (t *Trainer) Release(id int) (*CaughtPokemon, error) {
+	p, place, ok := t.Find(id)
+	if !ok {
+		return nil, fmt.Errorf("you don't have a Pokemon with ID %d", id)
+	}
+
+	if place.Box == 0 {
+		if len(t.Party) == 1 && len(t.Owned()) > 1 {
+			return nil, errors.New("you can't release your last party Pokemon while others are boxed")
+		}
+		t.Party = removeID(t.Party, id)
+	} else {
+		t.Boxes[place.Box-1] = removeID(t.Boxes[place.Box-1], id)
+	}
+	return p, nil
+}
+
+// ensureBoxes makes sure all PC boxes exist.
+func (t *Trainer) ensureBoxes() {
+	for len(t.Boxes) < NumBoxes {
+		t.Boxes = append(t.Boxes, []*CaughtPokemon{})
+	}
+}
+
+func indexOf(pokemon []*CaughtPokemon, id int) int {
+	for i, p := range pokemon {
+		if p.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func removeID(pokemon []*CaughtPokemon, id int) []*CaughtPokemon {
+	i := indexOf(pokemon, id)
+	return append(pokemon[:i], pokemon[i+1:]...)
+}
@@ -0,0 +1,120 @@
+package pokeapi
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func trainerWith(n int) *Trainer {
+	t := NewTrainer()
+	for i := 0; i < n; i++ {
+		t.AddCaught(Pokemon{Name: fmt.Sprintf("pokemon-%d", i)}, "", time.Now())
+	}
+	return t
+}
+
+func TestAddCaught_FillsPartyThenBoxes(t *testing.T) {
+	trainer := trainerWith(PartySize)
+	assert.Len(t, trainer.Party, PartySize)
+
+	caught, place, err := trainer.AddCaught(Pokemon{Name: "pikachu"}, "pallet-town-area", time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, PartySize+1, caught.ID)
+	assert.Equal(t, "pallet-town-area", caught.Location)
+	assert.Equal(t, Place{Box: 1}, place)
+	assert.Equal(t, "box 1", place.String())
+}
+
+func TestAddCaught_SameSpeciesTwice(t *testing.T) {
+	trainer := NewTrainer()
+	first, _, _ := trainer.AddCaught(Pokemon{Name: "pikachu"}, "", time.Now())
+	second, _, _ := trainer.AddCaught(Pokemon{Name: "pikachu"}, "", time.Now())
+
+	assert.NotEqual(t, first.ID, second.ID)
+	assert.Len(t, trainer.Owned(), 2)
+}
+
+func TestAddCaught_Full(t *testing.T) {
+	trainer := trainerWith(PartySize + NumBoxes*BoxSize)
+	assert.False(t, trainer.HasRoom())
+
+	_, _, err := trainer.AddCaught(Pokemon{Name: "pikachu"}, "", time.Now())
+	assert.ErrorIs(t, err, ErrCollectionFull)
+	assert.Equal(t, PartySize+NumBoxes*BoxSize, trainer.NextID)
+}
+
+func TestLookup(t *testing.T) {
+	trainer := NewTrainer()
+	trainer.AddCaught(Pokemon{Name: "bulbasaur"}, "", time.Now())
+	trainer.AddCaught(Pokemon{Name: "pikachu"}, "", time.Now())
+
+	p, ok := trainer.Lookup("2")
+	assert.True(t, ok)
+	assert.Equal(t, "pikachu", p.Species)
+
+	p, ok = trainer.Lookup("bulbasaur")
+	assert.True(t, ok)
+	assert.Equal(t, 1, p.ID)
+
+	_, ok = trainer.Lookup("mewtwo")
+	assert.False(t, ok)
+}
+
+func TestDepositWithdraw(t *testing.T) {
+	trainer := trainerWith(3)
+
+	box, err := trainer.Deposit(2, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, box)
+	assert.Len(t, trainer.Party, 2)
+	_, place, _ := trainer.Find(2)
+	assert.Equal(t, Place{Box: 1}, place)
+
+	_, err = trainer.Deposit(3, 5)
+	assert.NoError(t, err)
+	_, place, _ = trainer.Find(3)
+	assert.Equal(t, Place{Box: 5}, place)
+
+	_, err = trainer.Deposit(1, 0)
+	assert.ErrorContains(t, err, "last party Pokemon")
+
+	assert.NoError(t, trainer.Withdraw(2))
+	_, place, _ = trainer.Find(2)
+	assert.Equal(t, Place{}, place)
+	assert.Empty(t, trainer.Boxes[0])
+}
+
+func TestDepositWithdraw_Errors(t *testing.T) {
+	trainer := trainerWith(PartySize + 1)
+
+	_, err := trainer.Deposit(PartySize+1, 0)
+	assert.ErrorContains(t, err, "in your party")
+
+	_, err = trainer.Deposit(1, NumBoxes+1)
+	assert.ErrorContains(t, err, "box must be between")
+
+	err = trainer.Withdraw(1)
+	assert.ErrorContains(t, err, "in your PC boxes")
+
+	err = trainer.Withdraw(PartySize + 1)
+	assert.ErrorContains(t, err, "party already has")
+}
+
+func TestRelease(t *testing.T) {
+	trainer := trainerWith(PartySize + 1)
+
+	released, err := trainer.Release(PartySize + 1)
+	assert.NoError(t, err)
+	assert.Equal(t, PartySize+1, released.ID)
+	assert.Empty(t, trainer.Boxes[0])
+
+	_, err = trainer.Release(PartySize + 1)
+	assert.Error(t, err)
+
+	_, err = trainer.Release(1)
+	assert.NoError(t, err)
+	assert.Len(t, trainer.Party, PartySize-1)
+}
@@ -0,0 +1,375 @@
+package pokeapi
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DexStatus is how much the trainer knows about a species.
+type DexStatus string
+
+const (
+	DexSeen   DexStatus = "seen"
+	DexCaught DexStatus = "caught"
+)
+
+// PokemonEntry is a species' number within a pokedex.
+type PokemonEntry struct {
+	EntryNumber    int              `json:"entry_number"`
+	PokemonSpecies NamedAPIResource `json:"pokemon_species"`
+}
+
+// Pokedex is the national dex or one region's dex, in entry order.
+type Pokedex struct {
+	Name           string            `json:"name"`
+	IsMainSeries   bool              `json:"is_main_series"`
+	Region         *NamedAPIResource `json:"region"`
+	PokemonEntries []PokemonEntry    `json:"pokemon_entries"`
+}
+
+// Generation is a group of games and the species they introduced.
+type Generation struct {
+	Name           string             `json:"name"`
+	MainRegion     NamedAPIResource   `json:"main_region"`
+	PokemonSpecies []NamedAPIResource `json:"pokemon_species"`
+}
+
+// DexProgress is how much of a list of species the trainer has seen and
+// caught.
+type DexProgress struct {
+	Name   string
+	Total  int
+	Seen   int
+	Caught int
+	// Missing lists the species not yet caught, in the list's order.
+	Missing []string
+}
+
+// GetPokedex fetches a pokedex. The national dex is only fetched once per
+// client.
+func (c *Client) GetPokedex(name string) (Pokedex, error) {
+	if name == "national" && c.nationalDex != nil {
+		return *c.nationalDex, nil
+	}
+	url := c.PokeapiBaseURL + "pokedex/" + name
+	var pokedex Pokedex
+
+	err := c.fetchAndCache(url, &pokedex)
+	if err != nil {
+		return Pokedex{}, err
+	}
+
+	if name == "national" {
+		c.nationalDex = &pokedex
+	}
+	return pokedex, nil
+}
+
+func (c *Client) GetGeneration(name string) (Generation, error) {
+	url := c.PokeapiBaseURL + "generation/" + name
+	var generation Generation
+
+	err := c.fetchAndCache(url, &generation)
+	if err != nil {
+		return Generation{}, err
+	}
+
+	return generation, nil
+}
+
+// Species lists the pokedex's species in entry order.
+func (p Pokedex) Species() []string {
+	species := make([]string, 0, len(p.PokemonEntries))
+	for _, entry := range p.PokemonEntries {
+		species = append(species, entry.PokemonSpecies.Name)
+	}
+	return species
+}
+
+// Species lists the species introduced in the generation.
+func (g Generation) Species() []string {
+	species := make([]string, 0, len(g.PokemonSpecies))
+	for _, s := range g.PokemonSpecies {
+		species = append(species, s.Name)
+	}
+	return species
+}
+
+// PokemonNames lists the Pokemon that have the type.
+func (t TypeResponse) PokemonNames() []string {
+	names := make([]string, 0, len(t.Pokemon))
+	for _, p := range t.Pokemon {
+		names = append(names, p.Pokemon.Name)
+	}
+	return names
+}
+
+// TypeSpecies lists the species with the type, once each. A type lists
+// Pokemon, which include forms such as wormadam-plant or charizard-mega-x.
+func (c *Client) TypeSpecies(t TypeResponse) ([]string, error) {
+	national, err := c.GetPokedex("national")
+	if err != nil {
+		return nil, err
+	}
+	return formSpecies(t.PokemonNames(), national.Species()), nil
+}
+
+// formSpecies maps Pokemon names to the species they belong to, dropping
+// repeats. A form is named after its species, so a name that isn't a
+// species belongs to the longest species it starts with.
+func formSpecies(pokemon, species []string) []string {
+	known := make(map[string]bool, len(species))
+	for _, s := range species {
+		known[s] = true
+	}
+	var names []string
+	for _, name := range pokemon {
+		s := name
+		for prefix := name; !known[prefix]; {
+			i := strings.LastIndex(prefix, "-")
+			if i < 0 {
+				break
+			}
+			prefix = prefix[:i]
+			if known[prefix] {
+				s = prefix
+			}
+		}
+		if !slices.Contains(names, s) {
+			names = append(names, s)
+		}
+	}
+	return names
+}
+
+// Status returns what the trainer knows about a species, or "" if they have
+// never come across it.
+func (t *Trainer) Status(species string) DexStatus {
+	return t.Dex[species]
+}
+
+// See records a species as seen, unless it has already been caught.
+func (t *Trainer) See(species string) {
+	if t.Dex == nil {
+		t.Dex = make(map[string]DexStatus)
+	}
+	if t.Dex[species] == "" {
+		t.Dex[species] = DexSeen
+	}
+}
+
+// SeePokemon records the species of the named Pokemon as seen.
+func (c *Client) SeePokemon(name string) error {
+	species, err := c.speciesOf(name)
+	if err != nil {
+		return err
+	}
+	c.Trainer.See(species)
+	return nil
+}
+
+// speciesOf returns the species the named Pokemon belongs to. Forms such
+// as wormadam-plant are always named species-form, so only hyphenated
+// names need looking up.
+func (c *Client) speciesOf(name string) (string, error) {
+	if !strings.Contains(name, "-") {
+		return name, nil
+	}
+	pokemon, err := c.GetPokemon(name)
+	if err != nil {
+		return "", err
+	}
+	return pokemon.SpeciesName(), nil
+}
+
+// markCaught records a species as caught in the trainer's dex.
+func (t *Trainer) markCaught(species string) {
+	if t.Dex == nil {
+		t.Dex = make(map[string]DexStatus)
+	}
+	t.Dex[species] = DexCaught
+}
+
+// Progress counts how many of species the trainer has seen and caught.
+// Caught species count as seen.
+func (t *Trainer) Progress(name string, species []string) DexProgress {
+	progress := DexProgress{Name: name, Total: len(species), Missing: []string{}}
+	for _, s := range species {
+		switch t.Status(s) {
+		case DexCaught:
+			progress.Caught++
+			progress.Seen++
+			continue
+		case DexSeen:
+			progress.Seen++
+		}
+		progress.Missing = append(progress.Missing, s)
+	}
+	return progress
+}
+
+// Percent is the share of the list caught, from 0 to 100.
+func (p DexProgress) Percent() float64 {
+	if p.Total == 0 {
+		return 0
+	}
+	return float64(p.Caught) * 100 / float64(p.Total)
+}
+
+// DexSortKeys are the orders a pokedex listing can take, besides any stat
+// in StatNames.
+var DexSortKeys = []string{"number", "name", "caught", "level"}
+
+// DexQuery filters and orders the trainer's caught Pokemon. Zero fields
+// match everything.
+type DexQuery struct {
+	// Name is a glob such as "pi*" matched against the species and
+	// nickname.
+	Name string
+	Type string
+	Tag  string
+	// Note matches Pokemon whose notes contain it, ignoring case.
+	Note string
+	// Generation is a generation resource name, e.g. "generation-iv".
+	Generation string
+	// MinStats are minimum base stats, keyed by name in StatNames.
+	MinStats map[string]int
+	// Sort is one of DexSortKeys or a stat name; it defaults to national
+	// dex number. Stats and levels sort highest first.
+	Sort    string
+	Reverse bool
+}
+
+// DexEntry is a caught Pokemon with its species' national dex number,
+// which is zero if the national dex doesn't list it.
+type DexEntry struct {
+	Number int
+	*CaughtPokemon
+}
+
+// QueryPokedex lists the caught Pokemon matching q, in q's order.
+func (c *Client) QueryPokedex(q DexQuery) ([]DexEntry, error) {
+	if q.Sort == "" {
+		q.Sort = "number"
+	}
+	if !slices.Contains(DexSortKeys, q.Sort) && !slices.Contains(StatNames, q.Sort) {
+		return nil, fmt.Errorf("can't sort by %q, try one of %s", q.Sort,
+			strings.Join(append(slices.Clone(DexSortKeys), StatNames...), ", "))
+	}
+	if _, err := path.Match(q.Name, ""); err != nil {
+		return nil, fmt.Errorf("invalid name pattern %q: %w", q.Name, err)
+	}
+
+	national, err := c.GetPokedex("national")
+	if err != nil {
+		return nil, err
+	}
+	numbers := make(map[string]int)
+	for _, entry := range national.PokemonEntries {
+		numbers[entry.PokemonSpecies.Name] = entry.EntryNumber
+	}
+
+	var generation []string
+	if q.Generation != "" {
+		g, err := c.GetGeneration(q.Generation)
+		if err != nil {
+			return nil, err
+		}
+		generation = g.Species()
+	}
+
+	var entries []DexEntry
+	for _, cp := range c.Trainer.Owned() {
+		if q.Name != "" {
+			species, _ := path.Match(q.Name, cp.Species)
+			nickname, _ := path.Match(q.Name, cp.Nickname)
+			if !species && !(nickname && cp.Nickname != "") {
+				continue
+			}
+		}
+		if q.Tag != "" && !cp.HasTag(q.Tag) {
+			continue
+		}
+		if q.Note != "" && !strings.Contains(strings.ToLower(cp.Notes), strings.ToLower(q.Note)) {
+			continue
+		}
+		if q.Type != "" && !hasType(cp.Pokemon, q.Type) {
+			continue
+		}
+		if q.Generation != "" && !slices.Contains(generation, cp.Species) {
+			continue
+		}
+		if !meetsMinStats(BaseStats(cp.Pokemon), q.MinStats) {
+			continue
+		}
+		entries = append(entries, DexEntry{Number: numbers[cp.Species], CaughtPokemon: cp})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if q.Reverse {
+			a, b = b, a
+		}
+		if less, ok := dexLess(q.Sort, a, b); ok {
+			return less
+		}
+		return a.ID < b.ID
+	})
+	return entries, nil
+}
+
+// dexLess compares two entries by key, reporting ok false on a tie.
+func dexLess(key string, a, b DexEntry) (less, ok bool) {
+	switch key {
+	case "number":
+		return a.Number < b.Number, a.Number != b.Number
+	case "name":
+		return a.Species < b.Species, a.Species != b.Species
+	case "caught":
+		return a.CaughtAt.Before(b.CaughtAt), !a.CaughtAt.Equal(b.CaughtAt)
+	case "level":
+		return a.Level > b.Level, a.Level != b.Level
+	}
+	sa, sb := BaseStats(a.Pokemon).Get(key), BaseStats(b.Pokemon).Get(key)
+	return sa > sb, sa != sb
+}
+
+func hasType(p Pokemon, typeName string) bool {
+	for _, t := range p.Types {
+		if t.Type.Name == typeName {
+			return true
+		}
+	}
+	return false
+}
+
+func meetsMinStats(base StatSet, minimums map[string]int) bool {
+	for name, minimum := range minimums {
+		if base.Get(name) < minimum {
+			return false
+		}
+	}
+	return true
+}
+
+var romanNumerals = []string{"i", "ii", "iii", "iv", "v", "vi", "vii", "viii", "ix"}
+
+// GenerationName turns "4", "iv" or "generation-iv" into PokeAPI's
+// "generation-iv".
+func GenerationName(gen string) (string, error) {
+	gen = strings.TrimPrefix(strings.ToLower(gen), "generation-")
+	if n, err := strconv.Atoi(gen); err == nil {
+		if n < 1 || n > len(romanNumerals) {
+			return "", fmt.Errorf("generation must be between 1 and %d", len(romanNumerals))
+		}
+		gen = romanNumerals[n-1]
+	}
+	if !slices.Contains(romanNumerals, gen) {
+		return "", fmt.Errorf("unknown generation %q", gen)
+	}
+	return "generation-" + gen, nil
+}
@@ -0,0 +1,213 @@
+package pokeapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/danalytis/pokedexcli/internal/pokecache"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrainer_Progress(t *testing.T) {
+	trainer := NewTrainer()
+	trainer.AddCaught(Pokemon{Name: "pikachu"}, "", time.Now())
+	trainer.Dex["gible"] = DexSeen
+
+	progress := trainer.Progress("test", []string{"pichu", "pikachu", "gible", "raichu"})
+	assert.Equal(t, 4, progress.Total)
+	assert.Equal(t, 1, progress.Caught)
+	assert.Equal(t, 2, progress.Seen)
+	assert.Equal(t, []string{"pichu", "gible", "raichu"}, progress.Missing)
+	assert.Equal(t, 25.0, progress.Percent())
+
+	assert.Equal(t, 0.0, trainer.Progress("empty", nil).Percent())
+}
+
+func TestDexProgress_PokedexGenerationAndType(t *testing.T) {
+	client, _ := newTestClient(t)
+	client.Trainer.AddCaught(Pokemon{Name: "gible"}, "", time.Now())
+
+	dex, err := client.GetPokedex("original-sinnoh")
+	assert.NoError(t, err)
+	assert.Equal(t, "sinnoh", dex.Region.Name)
+	assert.Equal(t, 1, client.Trainer.Progress(dex.Name, dex.Species()).Caught)
+
+	national, err := client.GetPokedex("national")
+	assert.NoError(t, err)
+	assert.Equal(t, "bulbasaur", national.Species()[0])
+
+	generation, err := client.GetGeneration("generation-iv")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"shellos", "gible"}, generation.Species())
+
+	dragon, err := client.GetType("dragon")
+	assert.NoError(t, err)
+	assert.Contains(t, dragon.PokemonNames(), "gible")
+	assert.Empty(t, client.Trainer.Progress(dragon.Name, dragon.PokemonNames()).Missing)
+}
+
+func TestTypeSpecies_Forms(t *testing.T) {
+	client, _ := newTestClient(t)
+	client.Rand = fixedRand{roll: 0}
+	_, err := client.CatchPokemon("wormadam-plant", CatchOptions{})
+	assert.NoError(t, err)
+
+	grass, err := client.GetType("grass")
+	assert.NoError(t, err)
+	assert.Contains(t, grass.PokemonNames(), "wormadam-plant")
+	species, err := client.TypeSpecies(grass)
+	assert.NoError(t, err)
+	assert.Contains(t, species, "wormadam")
+	assert.NotContains(t, species, "wormadam-plant")
+	progress := client.Trainer.Progress(grass.Name, species)
+	assert.Equal(t, 1, progress.Caught)
+	assert.NotContains(t, progress.Missing, "wormadam")
+}
+
+func TestFormSpecies(t *testing.T) {
+	species := []string{"charizard", "mr-mime", "tapu-bulu", "wormadam", "porygon-z", "porygon"}
+	assert.Equal(t,
+		[]string{"charizard", "mr-mime", "tapu-bulu", "wormadam", "porygon-z", "porygon", "missing-no"},
+		formSpecies([]string{
+			"charizard", "charizard-mega-x", "charizard-mega-y", "mr-mime-galar",
+			"tapu-bulu", "wormadam-plant", "wormadam-sandy", "porygon-z", "porygon",
+			"missing-no",
+		}, species))
+}
+
+func TestEvolve_RecordsNewSpecies(t *testing.T) {
+	client, _ := newTestClient(t)
+	caught := catchForTest(t, client, "bulbasaur", 16)
+
+	_, err := client.Evolve(caught.ID, EvolveOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, DexCaught, client.Trainer.Status("ivysaur"))
+	assert.Equal(t, DexCaught, client.Trainer.Status("bulbasaur"))
+}
+
+func TestTrainer_SeeKeepsCaught(t *testing.T) {
+	trainer := NewTrainer()
+	trainer.See("gible")
+	assert.Equal(t, DexSeen, trainer.Status("gible"))
+
+	trainer.AddCaught(Pokemon{Name: "gible"}, "", time.Now())
+	trainer.See("gible")
+	assert.Equal(t, DexCaught, trainer.Status("gible"))
+}
+
+func TestCatchPokemon_EscapeMarksSeen(t *testing.T) {
+	client, _ := newTestClient(t)
+	client.Rand = fixedRand{roll: 999}
+
+	result, err := client.CatchPokemon("mewtwo", CatchOptions{})
+	assert.NoError(t, err)
+	assert.False(t, result.Caught)
+	assert.Equal(t, DexSeen, client.Trainer.Status("mewtwo"))
+
+	caught, err := client.InspectPokemon("mewtwo")
+	assert.NoError(t, err)
+	assert.False(t, caught)
+}
+
+func TestCatchPokemon_EscapedFormMarksSpeciesSeen(t *testing.T) {
+	client, _ := newTestClient(t)
+	client.Rand = fixedRand{roll: 999}
+
+	result, err := client.CatchPokemon("wormadam-plant", CatchOptions{})
+	assert.NoError(t, err)
+	assert.False(t, result.Caught)
+	assert.Equal(t, map[string]DexStatus{"wormadam": DexSeen}, client.Trainer.Dex)
+
+	caught, err := client.InspectPokemon("wormadam-plant")
+	assert.NoError(t, err)
+	assert.False(t, caught)
+
+	assert.NoError(t, client.SeePokemon("wormadam-plant"))
+	assert.Error(t, client.SeePokemon("missing-no"))
+}
+
+func TestRollEncounter_MarksSeen(t *testing.T) {
+	client, _ := newTestClient(t)
+	client.Rand = fixedRand{roll: 0}
+
+	wild, err := client.RollEncounter("wayward-cave-1f", EncounterFilter{})
+	assert.NoError(t, err)
+	assert.Equal(t, DexSeen, client.Trainer.Status(wild.Pokemon))
+}
+
+func TestQueryPokedex_FiltersAndSorts(t *testing.T) {
+	client, _ := newTestClient(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, name := range []string{"pikachu", "gible", "bulbasaur", "pichu"} {
+		p, err := client.GetPokemon(name)
+		assert.NoError(t, err)
+		client.Trainer.AddCaught(p, "", start.Add(time.Duration(-i)*time.Hour))
+	}
+	species := func(entries []DexEntry) []string {
+		var names []string
+		for _, e := range entries {
+			names = append(names, e.Species)
+		}
+		return names
+	}
+
+	entries, err := client.QueryPokedex(DexQuery{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bulbasaur", "pikachu", "pichu", "gible"}, species(entries))
+	assert.Equal(t, 1, entries[0].Number)
+
+	entries, err = client.QueryPokedex(DexQuery{Sort: "caught"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"pichu", "bulbasaur", "gible", "pikachu"}, species(entries))
+
+	entries, err = client.QueryPokedex(DexQuery{Name: "pi*", Sort: "speed"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"pikachu", "pichu"}, species(entries))
+
+	entries, err = client.QueryPokedex(DexQuery{Type: "electric", Generation: "generation-ii"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"pichu"}, species(entries))
+
+	entries, err = client.QueryPokedex(DexQuery{MinStats: map[string]int{"attack": 60}, Sort: "name", Reverse: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"gible"}, species(entries))
+
+	_, err = client.QueryPokedex(DexQuery{Sort: "height"})
+	assert.ErrorContains(t, err, "can't sort by")
+}
+
+func TestGenerationName(t *testing.T) {
+	for _, gen := range []string{"4", "iv", "generation-iv"} {
+		name, err := GenerationName(gen)
+		assert.NoError(t, err)
+		assert.Equal(t, "generation-iv", name)
+	}
+	_, err := GenerationName("10")
+	assert.Error(t, err)
+	_, err = GenerationName("x")
+	assert.Error(t, err)
+}
+
+func TestGetPokedex_KeepsNationalDex(t *testing.T) {
+	client, server := newTestClient(t)
+	national, err := client.GetPokedex("national")
+	assert.NoError(t, err)
+
+	// An expired cache entry doesn't send the national dex back to the
+	// server; regional dexes are still fetched again.
+	_, err = client.GetPokedex("kanto")
+	assert.NoError(t, err)
+	expired := pokecache.NewCache(5 * time.Minute)
+	client.Cache = &expired
+
+	again, err := client.GetPokedex("national")
+	assert.NoError(t, err)
+	assert.Equal(t, national, again)
+	_, err = client.QueryPokedex(DexQuery{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, server.Requests("pokedex/national"))
+
+	_, err = client.GetPokedex("kanto")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, server.Requests("pokedex/kanto"))
+}
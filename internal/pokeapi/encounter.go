@@ -0,0 +1,164 @@
+package pokeapi
+
+import (
+	"fmt"
+	"sort"
+)
+
+// VersionEncounterDetail is how a Pokemon can be found in an area in one
+// game version.
+type VersionEncounterDetail struct {
+	Version          NamedAPIResource `json:"version"`
+	MaxChance        int              `json:"max_chance"`
+	EncounterDetails []Encounter      `json:"encounter_details"`
+}
+
+// Encounter is a single encounter slot: a method such as walk, surf or
+// old-rod, the percent chance of the slot and the level range.
+type Encounter struct {
+	MinLevel        int                `json:"min_level"`
+	MaxLevel        int                `json:"max_level"`
+	Chance          int                `json:"chance"`
+	Method          NamedAPIResource   `json:"method"`
+	ConditionValues []NamedAPIResource `json:"condition_values"`
+}
+
+// EncounterFilter narrows encounters to one method and game version. Empty
+// fields match anything.
+type EncounterFilter struct {
+	Method  string
+	Version string
+}
+
+func (f EncounterFilter) matches(version, method string) bool {
+	return (f.Method == "" || f.Method == method) && (f.Version == "" || f.Version == version)
+}
+
+// String describes the filter as a suffix, e.g. " by surf in pearl".
+func (f EncounterFilter) String() string {
+	s := ""
+	if f.Method != "" {
+		s += " by " + f.Method
+	}
+	if f.Version != "" {
+		s += " in " + f.Version
+	}
+	return s
+}
+
+// EncounterSlot is one Pokemon's encounter slot flattened out of an area's
+// encounter data.
+type EncounterSlot struct {
+	Area     string
+	Pokemon  string
+	Version  string
+	Method   string
+	Chance   int
+	MinLevel int
+	MaxLevel int
+}
+
+// WildEncounter is a Pokemon that has appeared in an area.
+type WildEncounter struct {
+	EncounterSlot
+	Area  string
+	Level int
+}
+
+// Slots lists every encounter slot in the area that matches filter.
+func (r ExploreLocationResponse) Slots(filter EncounterFilter) []EncounterSlot {
+	var slots []EncounterSlot
+	for _, pe := range r.PokemonEncounters {
+		for _, vd := range pe.VersionDetails {
+			for _, e := range vd.EncounterDetails {
+				if !filter.matches(vd.Version.Name, e.Method.Name) {
+					continue
+				}
+				slots = append(slots, EncounterSlot{
+					Area:     r.Name,
+					Pokemon:  pe.Pokemon.Name,
+					Version:  vd.Version.Name,
+					Method:   e.Method.Name,
+					Chance:   e.Chance,
+					MinLevel: e.MinLevel,
+					MaxLevel: e.MaxLevel,
+				})
+			}
+		}
+	}
+	return slots
+}
+
+// Slots lists every encounter slot matching filter across all the areas,
+// best odds first.
+func (r PokemonEncountersResponse) Slots(pokemon string, filter EncounterFilter) []EncounterSlot {
+	var slots []EncounterSlot
+	for _, ae := range r {
+		for _, vd := range ae.VersionDetails {
+			for _, e := range vd.EncounterDetails {
+				if !filter.matches(vd.Version.Name, e.Method.Name) {
+					continue
+				}
+				slots = append(slots, EncounterSlot{
+					Area:     ae.LocationArea.Name,
+					Pokemon:  pokemon,
+					Version:  vd.Version.Name,
+					Method:   e.Method.Name,
+					Chance:   e.Chance,
+					MinLevel: e.MinLevel,
+					MaxLevel: e.MaxLevel,
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(slots, func(i, j int) bool {
+		a, b := slots[i], slots[j]
+		if a.Chance != b.Chance {
+			return a.Chance > b.Chance
+		}
+		if a.Area != b.Area {
+			return a.Area < b.Area
+		}
+		return a.Version < b.Version
+	})
+	return slots
+}
+
+// RollEncounter picks a wild Pokemon in area, weighting each matching
+// encounter slot by its chance, and rolls its level within the slot's
+// range. The trainer has then seen the Pokemon.
+func (c *Client) RollEncounter(area string, filter EncounterFilter) (WildEncounter, error) {
+	resp, err := c.ExploreLocation(area)
+	if err != nil {
+		return WildEncounter{}, err
+	}
+
+	slots := resp.Slots(filter)
+	total := 0
+	for _, slot := range slots {
+		total += slot.Chance
+	}
+	if total == 0 {
+		return WildEncounter{}, fmt.Errorf("no Pokemon can be encountered in %s%s", area, filter)
+	}
+
+	roll := c.Rand.Intn(total)
+	slot := slots[len(slots)-1]
+	for _, s := range slots {
+		if roll < s.Chance {
+			slot = s
+			break
+		}
+		roll -= s.Chance
+	}
+
+	level := slot.MinLevel
+	if slot.MaxLevel > slot.MinLevel {
+		level += c.Rand.Intn(slot.MaxLevel - slot.MinLevel + 1)
+	}
+	if err := c.SeePokemon(slot.Pokemon); err != nil {
+		return WildEncounter{}, err
+	}
+	return WildEncounter{EncounterSlot: slot, Area: area, Level: max(1, level)}, nil
+}
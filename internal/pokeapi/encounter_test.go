@@ -0,0 +1,88 @@
+package pokeapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExploreLocation_DecodesVersionDetails(t *testing.T) {
+	client, _ := newTestClient(t)
+
+	resp, err := client.ExploreLocation("canalave-city-area")
+	assert.NoError(t, err)
+
+	tentacool := resp.PokemonEncounters[0]
+	assert.Equal(t, "tentacool", tentacool.Pokemon.Name)
+	assert.Len(t, tentacool.VersionDetails, 2)
+	assert.Equal(t, "diamond", tentacool.VersionDetails[0].Version.Name)
+	assert.Equal(t, Encounter{MinLevel: 15, MaxLevel: 25, Chance: 40, Method: NamedAPIResource{Name: "good-rod"}},
+		tentacool.VersionDetails[0].EncounterDetails[1])
+
+	assert.Len(t, resp.Slots(EncounterFilter{}), 5)
+	assert.Len(t, resp.Slots(EncounterFilter{Method: "surf"}), 4)
+	assert.Len(t, resp.Slots(EncounterFilter{Method: "surf", Version: "pearl"}), 2)
+}
+
+func TestRollEncounter_WeightedByChance(t *testing.T) {
+	cases := []struct {
+		roll     int
+		expected WildEncounter
+	}{
+		// Pearl surfing slots are tentacool (60) then shellos (40).
+		{30, WildEncounter{Level: 28, EncounterSlot: EncounterSlot{Pokemon: "tentacool", Chance: 60}}},
+		{70, WildEncounter{Level: 24, EncounterSlot: EncounterSlot{Pokemon: "shellos", Chance: 40}}},
+	}
+	for _, c := range cases {
+		client, _ := newTestClient(t)
+		client.Rand = fixedRand{roll: c.roll}
+
+		wild, err := client.RollEncounter("canalave-city-area", EncounterFilter{Method: "surf", Version: "pearl"})
+		assert.NoError(t, err)
+		assert.Equal(t, c.expected.Pokemon, wild.Pokemon)
+		assert.Equal(t, c.expected.Chance, wild.Chance)
+		assert.Equal(t, c.expected.Level, wild.Level)
+		assert.Equal(t, "surf", wild.Method)
+		assert.Equal(t, "canalave-city-area", wild.Area)
+	}
+}
+
+func TestRollEncounter_NoMatchingSlots(t *testing.T) {
+	client, _ := newTestClient(t)
+
+	_, err := client.RollEncounter("canalave-city-area", EncounterFilter{Method: "old-rod"})
+	assert.ErrorContains(t, err, "no Pokemon can be encountered in canalave-city-area by old-rod")
+
+	_, err = client.RollEncounter("eterna-city-area", EncounterFilter{})
+	assert.ErrorContains(t, err, "no Pokemon can be encountered in eterna-city-area")
+}
+
+func TestGetPokemonEncounters_SortedByOdds(t *testing.T) {
+	client, _ := newTestClient(t)
+
+	encounters, err := client.GetPokemonEncounters("shellos")
+	assert.NoError(t, err)
+	assert.Len(t, encounters, 3)
+
+	slots := encounters.Slots("shellos", EncounterFilter{Version: "diamond"})
+	var areas []string
+	for _, slot := range slots {
+		areas = append(areas, slot.Area)
+	}
+	// Sunyshore's seeded walking slot gives shellos a 50% chance.
+	assert.Equal(t, []string{"sunyshore-city-area", "canalave-city-area", "valley-windworks-area"}, areas)
+	assert.Equal(t, EncounterSlot{Area: "canalave-city-area", Pokemon: "shellos", Version: "diamond", Method: "surf", Chance: 40, MinLevel: 20, MaxLevel: 30}, slots[1])
+
+	assert.Len(t, encounters.Slots("shellos", EncounterFilter{Method: "surf"}), 2)
+}
+
+func TestGetPokemonEncounters_NoneKnown(t *testing.T) {
+	client, _ := newTestClient(t)
+
+	encounters, err := client.GetPokemonEncounters("ivysaur")
+	assert.NoError(t, err)
+	assert.Empty(t, encounters)
+
+	_, err = client.GetPokemonEncounters("fakemon")
+	assert.Error(t, err)
+}
@@ -0,0 +1,310 @@
+package pokeapi
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// EvolutionDetail is one way a species can evolve into the next. Every
+// condition PokeAPI has is decoded, so that the ones this CLI can't check
+// refuse the evolution rather than being skipped.
+type EvolutionDetail struct {
+	Trigger      NamedAPIResource  `json:"trigger"`
+	MinLevel     *int              `json:"min_level"`
+	MinHappiness *int              `json:"min_happiness"`
+	Item         *NamedAPIResource `json:"item"`
+	HeldItem     *NamedAPIResource `json:"held_item"`
+	KnownMove    *NamedAPIResource `json:"known_move"`
+	Location     *NamedAPIResource `json:"location"`
+	TradeSpecies *NamedAPIResource `json:"trade_species"`
+	TimeOfDay    string            `json:"time_of_day"`
+
+	KnownMoveType *NamedAPIResource `json:"known_move_type"`
+	MinAffection  *int              `json:"min_affection"`
+	MinBeauty     *int              `json:"min_beauty"`
+	// Gender is 1 for female and 2 for male.
+	Gender *int `json:"gender"`
+	// RelativePhysicalStats compares Attack to Defense: 1 for higher, 0
+	// for equal and -1 for lower.
+	RelativePhysicalStats *int              `json:"relative_physical_stats"`
+	PartySpecies          *NamedAPIResource `json:"party_species"`
+	PartyType             *NamedAPIResource `json:"party_type"`
+	NeedsOverworldRain    bool              `json:"needs_overworld_rain"`
+	TurnUpsideDown        bool              `json:"turn_upside_down"`
+}
+
+// ChainLink is a species in an evolution chain together with everything it
+// can evolve into. EvolutionDetails describes how the previous link
+// evolves into this one and is empty for the root.
+type ChainLink struct {
+	Species          NamedAPIResource  `json:"species"`
+	IsBaby           bool              `json:"is_baby"`
+	EvolutionDetails []EvolutionDetail `json:"evolution_details"`
+	EvolvesTo        []ChainLink       `json:"evolves_to"`
+}
+
+type EvolutionChain struct {
+	ID    int       `json:"id"`
+	Chain ChainLink `json:"chain"`
+}
+
+// EvolveOptions supplies what a trainer can do to trigger an evolution.
+type EvolveOptions struct {
+	// Into picks a branch when a species can evolve in several ways.
+	Into string
+	// Item is used on the Pokemon, e.g. "thunder-stone". When set, only
+	// evolutions triggered by that item count, so using an item never
+	// sets off a level-up evolution.
+	Item string
+	// Trade simulates trading the Pokemon away and back.
+	Trade bool
+	// Time is checked against time-of-day conditions; zero means now.
+	Time time.Time
+}
+
+type EvolveResult struct {
+	From  string
+	To    string
+	Entry *CaughtPokemon
+}
+
+// GetEvolutionChain fetches the evolution chain a species belongs to.
+func (c *Client) GetEvolutionChain(species string) (EvolutionChain, error) {
+	s, err := c.GetPokemonSpecies(species)
+	if err != nil {
+		return EvolutionChain{}, err
+	}
+	if s.EvolutionChain.URL == "" {
+		return EvolutionChain{}, fmt.Errorf("%s has no evolution chain", species)
+	}
+
+	var chain EvolutionChain
+	if err := c.fetchAndCache(s.EvolutionChain.URL, &chain); err != nil {
+		return EvolutionChain{}, err
+	}
+	return chain, nil
+}
+
+// Find returns the link for the named species within the chain.
+func (l *ChainLink) Find(species string) (*ChainLink, bool) {
+	if l.Species.Name == species {
+		return l, true
+	}
+	for i := range l.EvolvesTo {
+		if found, ok := l.EvolvesTo[i].Find(species); ok {
+			return found, true
+		}
+	}
+	return nil, false
+}
+
+func (d EvolutionDetail) String() string {
+	var parts []string
+	switch d.Trigger.Name {
+	case "level-up":
+		if d.MinLevel != nil {
+			parts = append(parts, fmt.Sprintf("level %d", *d.MinLevel))
+		} else {
+			parts = append(parts, "level up")
+		}
+	case "use-item":
+		if d.Item != nil {
+			parts = append(parts, "use "+d.Item.Name)
+		}
+	default:
+		parts = append(parts, d.Trigger.Name)
+	}
+
+	if d.MinHappiness != nil {
+		parts = append(parts, fmt.Sprintf("friendship %d+", *d.MinHappiness))
+	}
+	if d.HeldItem != nil {
+		parts = append(parts, "holding "+d.HeldItem.Name)
+	}
+	if d.KnownMove != nil {
+		parts = append(parts, "knowing "+d.KnownMove.Name)
+	}
+	if d.KnownMoveType != nil {
+		parts = append(parts, "knowing a "+d.KnownMoveType.Name+" move")
+	}
+	if d.MinAffection != nil {
+		parts = append(parts, fmt.Sprintf("affection %d+", *d.MinAffection))
+	}
+	if d.MinBeauty != nil {
+		parts = append(parts, fmt.Sprintf("beauty %d+", *d.MinBeauty))
+	}
+	if d.Gender != nil {
+		parts = append(parts, map[int]string{1: "female", 2: "male"}[*d.Gender])
+	}
+	if d.RelativePhysicalStats != nil {
+		parts = append(parts, map[int]string{
+			1:  "attack above defense",
+			0:  "attack equal to defense",
+			-1: "attack below defense",
+		}[*d.RelativePhysicalStats])
+	}
+	if d.PartySpecies != nil {
+		parts = append(parts, "with "+d.PartySpecies.Name+" in the party")
+	}
+	if d.PartyType != nil {
+		parts = append(parts, "with a "+d.PartyType.Name+" Pokemon in the party")
+	}
+	if d.NeedsOverworldRain {
+		parts = append(parts, "in the rain")
+	}
+	if d.TurnUpsideDown {
+		parts = append(parts, "upside down")
+	}
+	if d.Location != nil {
+		parts = append(parts, "at "+d.Location.Name)
+	}
+	if d.TradeSpecies != nil {
+		parts = append(parts, "for "+d.TradeSpecies.Name)
+	}
+	if d.TimeOfDay != "" {
+		parts = append(parts, "at "+d.TimeOfDay)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// check reports why the caught Pokemon can't evolve this way right now, or
+// nil if it can.
+func (d EvolutionDetail) check(t *Trainer, cp *CaughtPokemon, opts EvolveOptions) error {
+	if opts.Item != "" && d.Trigger.Name != "use-item" {
+		return fmt.Errorf("doesn't evolve with a %s", opts.Item)
+	}
+	switch d.Trigger.Name {
+	case "level-up":
+	case "use-item":
+		if d.Item == nil {
+			return fmt.Errorf("needs an unknown item")
+		}
+		if opts.Item != d.Item.Name {
+			return fmt.Errorf("needs a %s", d.Item.Name)
+		}
+		if t.Items[opts.Item] <= 0 {
+			return fmt.Errorf("you have no %s", opts.Item)
+		}
+	case "trade":
+		if !opts.Trade {
+			return fmt.Errorf("needs a trade")
+		}
+	default:
+		return fmt.Errorf("%s evolutions aren't supported", d.Trigger.Name)
+	}
+
+	if d.unsupported() {
+		return fmt.Errorf("needs %s, which isn't supported", d)
+	}
+	if d.MinLevel != nil && cp.Level < *d.MinLevel {
+		return fmt.Errorf("needs level %d", *d.MinLevel)
+	}
+	if d.MinHappiness != nil && cp.Friendship < *d.MinHappiness {
+		return fmt.Errorf("needs friendship %d, has %d", *d.MinHappiness, cp.Friendship)
+	}
+	if d.HeldItem != nil && cp.HeldItem != d.HeldItem.Name && t.Items[d.HeldItem.Name] <= 0 {
+		return fmt.Errorf("needs to hold a %s", d.HeldItem.Name)
+	}
+	if !isTimeOfDay(opts.Time, d.TimeOfDay) {
+		return fmt.Errorf("only evolves at %s", d.TimeOfDay)
+	}
+	return nil
+}
+
+// unsupported reports whether the evolution depends on something this CLI
+// doesn't track, such as a Pokemon's gender, moves or the weather.
+func (d EvolutionDetail) unsupported() bool {
+	return d.KnownMove != nil || d.Location != nil || d.TradeSpecies != nil ||
+		d.KnownMoveType != nil || d.MinAffection != nil || d.MinBeauty != nil ||
+		d.Gender != nil || d.RelativePhysicalStats != nil ||
+		d.PartySpecies != nil || d.PartyType != nil ||
+		d.NeedsOverworldRain || d.TurnUpsideDown
+}
+
+// isTimeOfDay reports whether t falls in PokeAPI's named part of the day.
+// An empty name matches any time.
+func isTimeOfDay(t time.Time, timeOfDay string) bool {
+	hour := t.Hour()
+	switch timeOfDay {
+	case "day":
+		return hour >= 6 && hour < 18
+	case "night":
+		return hour < 6 || hour >= 18
+	case "dusk":
+		return hour == 17
+	}
+	return true
+}
+
+// Evolve evolves a caught Pokemon into the first species in its chain whose
+// conditions are met, consuming any item the evolution uses. Level, IVs,
+// EVs, nature and experience carry over.
+func (c *Client) Evolve(id int, opts EvolveOptions) (EvolveResult, error) {
+	cp, _, ok := c.Trainer.Find(id)
+	if !ok {
+		return EvolveResult{}, fmt.Errorf("you don't have a Pokemon with ID %d", id)
+	}
+	if opts.Time.IsZero() {
+		opts.Time = time.Now()
+	}
+
+	species := cp.Pokemon.SpeciesName()
+	chain, err := c.GetEvolutionChain(species)
+	if err != nil {
+		return EvolveResult{}, err
+	}
+	link, ok := chain.Chain.Find(species)
+	if !ok || len(link.EvolvesTo) == 0 {
+		return EvolveResult{}, fmt.Errorf("%s does not evolve", cp.Species)
+	}
+
+	var reasons []string
+	for _, next := range link.EvolvesTo {
+		if opts.Into != "" && next.Species.Name != opts.Into {
+			continue
+		}
+		for _, detail := range next.EvolutionDetails {
+			if err := detail.check(c.Trainer, cp, opts); err != nil {
+				reasons = append(reasons, fmt.Sprintf("%s %s", next.Species.Name, err))
+				continue
+			}
+			return c.evolve(cp, next.Species.Name, detail)
+		}
+	}
+
+	if len(reasons) == 0 {
+		return EvolveResult{}, fmt.Errorf("%s can't evolve into %s", cp.Species, opts.Into)
+	}
+	return EvolveResult{}, fmt.Errorf("%s can't evolve yet: %s", cp.Species, strings.Join(reasons, "; "))
+}
+
+func (c *Client) evolve(cp *CaughtPokemon, into string, detail EvolutionDetail) (EvolveResult, error) {
+	species, err := c.GetPokemonSpecies(into)
+	if err != nil {
+		return EvolveResult{}, err
+	}
+	evolved, err := c.GetPokemon(species.DefaultPokemon())
+	if err != nil {
+		return EvolveResult{}, err
+	}
+
+	for _, item := range []*NamedAPIResource{detail.Item, detail.HeldItem} {
+		if item == nil {
+			continue
+		}
+		if item == detail.HeldItem && cp.HeldItem == item.Name {
+			cp.HeldItem = ""
+			continue
+		}
+		if err := c.Trainer.UseItem(item.Name); err != nil {
+			return EvolveResult{}, err
+		}
+	}
+
+	result := EvolveResult{From: cp.Species, To: into, Entry: cp}
+	cp.Species = into
+	cp.Pokemon = evolved
+	c.Trainer.markCaught(into)
+	return result, nil
+}
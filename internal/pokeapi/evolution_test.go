@@ -0,0 +1,176 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func catchForTest(t *testing.T, client *Client, name string, level int) *CaughtPokemon {
+	t.Helper()
+	p, err := client.GetPokemon(name)
+	assert.NoError(t, err)
+	species, err := client.GetPokemonSpecies(p.SpeciesName())
+	assert.NoError(t, err)
+	caught, _, err := client.Trainer.AddCaught(p, "", time.Now())
+	assert.NoError(t, err)
+	caught.Level = level
+	caught.GrowthRate = species.GrowthRate.Name
+	return caught
+}
+
+func TestGetEvolutionChain_Branches(t *testing.T) {
+	client, _ := newTestClient(t)
+
+	chain, err := client.GetEvolutionChain("jolteon")
+	assert.NoError(t, err)
+	assert.Equal(t, "eevee", chain.Chain.Species.Name)
+	assert.Len(t, chain.Chain.EvolvesTo, 4)
+
+	link, ok := chain.Chain.Find("espeon")
+	assert.True(t, ok)
+	assert.Equal(t, "level up, friendship 160+, at day", link.EvolutionDetails[0].String())
+
+	_, err = client.GetEvolutionChain("gible")
+	assert.ErrorContains(t, err, "gible has no evolution chain")
+}
+
+func TestEvolve_LevelUp(t *testing.T) {
+	client, _ := newTestClient(t)
+	caught := catchForTest(t, client, "bulbasaur", 15)
+
+	_, err := client.Evolve(caught.ID, EvolveOptions{})
+	assert.ErrorContains(t, err, "ivysaur needs level 16")
+
+	caught.Level = 16
+	result, err := client.Evolve(caught.ID, EvolveOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "bulbasaur", result.From)
+	assert.Equal(t, "ivysaur", result.To)
+	assert.Equal(t, "ivysaur", caught.Species)
+	assert.Equal(t, "ivysaur", caught.Pokemon.Name)
+	assert.Equal(t, 16, caught.Level)
+}
+
+func TestEvolve_ItemIsConsumed(t *testing.T) {
+	client, _ := newTestClient(t)
+	caught := catchForTest(t, client, "eevee", 5)
+
+	_, err := client.Evolve(caught.ID, EvolveOptions{Item: "water-stone"})
+	assert.ErrorContains(t, err, "vaporeon you have no water-stone")
+
+	client.Trainer.AddItem("water-stone", 1)
+	result, err := client.Evolve(caught.ID, EvolveOptions{Item: "water-stone"})
+	assert.NoError(t, err)
+	assert.Equal(t, "vaporeon", result.To)
+	assert.Zero(t, client.Trainer.Items["water-stone"])
+}
+
+func TestEvolve_FriendshipAndTimeOfDay(t *testing.T) {
+	client, _ := newTestClient(t)
+	caught := catchForTest(t, client, "eevee", 20)
+	night := time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC)
+
+	_, err := client.Evolve(caught.ID, EvolveOptions{Time: night})
+	assert.ErrorContains(t, err, "umbreon needs friendship 160")
+
+	caught.Friendship = 200
+	_, err = client.Evolve(caught.ID, EvolveOptions{Into: "espeon", Time: night})
+	assert.ErrorContains(t, err, "espeon only evolves at day")
+
+	result, err := client.Evolve(caught.ID, EvolveOptions{Time: night})
+	assert.NoError(t, err)
+	assert.Equal(t, "umbreon", result.To)
+}
+
+func TestEvolve_Trade(t *testing.T) {
+	client, _ := newTestClient(t)
+	caught := catchForTest(t, client, "machoke", 30)
+
+	_, err := client.Evolve(caught.ID, EvolveOptions{})
+	assert.ErrorContains(t, err, "machamp needs a trade")
+
+	result, err := client.Evolve(caught.ID, EvolveOptions{Trade: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "machamp", result.To)
+}
+
+func TestEvolve_Errors(t *testing.T) {
+	client, _ := newTestClient(t)
+	caught := catchForTest(t, client, "mewtwo", 70)
+
+	_, err := client.Evolve(caught.ID, EvolveOptions{})
+	assert.ErrorContains(t, err, "mewtwo does not evolve")
+
+	eevee := catchForTest(t, client, "eevee", 5)
+	_, err = client.Evolve(eevee.ID, EvolveOptions{Into: "raichu"})
+	assert.ErrorContains(t, err, "eevee can't evolve into raichu")
+
+	_, err = client.Evolve(99, EvolveOptions{})
+	assert.ErrorContains(t, err, "don't have a Pokemon with ID 99")
+}
+
+func TestAwardExperience_RaisesFriendship(t *testing.T) {
+	client, _ := newTestClient(t)
+	caught := catchForTest(t, client, "pikachu", 5)
+	caught.Experience = 125
+	caught.Friendship = 50
+
+	_, err := client.AwardExperience(caught, 1000-125)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, caught.Level)
+	assert.Equal(t, 75, caught.Friendship)
+}
+
+func TestEvolve_Forms(t *testing.T) {
+	client, _ := newTestClient(t)
+	burmy := catchForTest(t, client, "burmy", 20)
+
+	result, err := client.Evolve(burmy.ID, EvolveOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "wormadam", result.To)
+	assert.Equal(t, "wormadam", burmy.Species)
+	assert.Equal(t, "wormadam-plant", burmy.Pokemon.Name)
+
+	_, err = client.Evolve(burmy.ID, EvolveOptions{})
+	assert.EqualError(t, err, "wormadam does not evolve")
+
+	wormadam := catchForTest(t, client, "wormadam-plant", 5)
+	assert.Equal(t, "wormadam", wormadam.Species)
+	assert.Equal(t, DexCaught, client.Trainer.Status("wormadam"))
+	_, err = client.AwardExperience(wormadam, 100)
+	assert.NoError(t, err, "the growth rate comes from the species")
+}
+
+func TestEvolutionDetail_UnsupportedConditions(t *testing.T) {
+	cp := &CaughtPokemon{Level: MaxLevel, Friendship: MaxFriendship}
+	trainer := NewTrainer()
+
+	// Each is PokeAPI's detail for a real evolution.
+	cases := []struct {
+		name, detail, needs string
+	}{
+		{"sylveon", `{"trigger": {"name": "level-up"}, "known_move_type": {"name": "fairy"}, "min_affection": 2}`,
+			"level up, knowing a fairy move, affection 2+"},
+		{"milotic", `{"trigger": {"name": "level-up"}, "min_beauty": 171}`, "level up, beauty 171+"},
+		{"wormadam", `{"trigger": {"name": "level-up"}, "min_level": 20, "gender": 1}`, "level 20, female"},
+		{"hitmonlee", `{"trigger": {"name": "level-up"}, "min_level": 20, "relative_physical_stats": 1}`,
+			"level 20, attack above defense"},
+		{"mantine", `{"trigger": {"name": "level-up"}, "party_species": {"name": "remoraid"}}`,
+			"level up, with remoraid in the party"},
+		{"pangoro", `{"trigger": {"name": "level-up"}, "min_level": 32, "party_type": {"name": "dark"}}`,
+			"level 32, with a dark Pokemon in the party"},
+		{"goodra", `{"trigger": {"name": "level-up"}, "min_level": 50, "needs_overworld_rain": true}`,
+			"level 50, in the rain"},
+		{"malamar", `{"trigger": {"name": "level-up"}, "min_level": 30, "turn_upside_down": true}`,
+			"level 30, upside down"},
+	}
+	for _, c := range cases {
+		var d EvolutionDetail
+		assert.NoError(t, json.Unmarshal([]byte(c.detail), &d))
+		assert.EqualError(t, d.check(trainer, cp, EvolveOptions{Time: time.Now()}),
+			"needs "+c.needs+", which isn't supported", c.name)
+	}
+}
@@ -0,0 +1,220 @@
+package pokeapi
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RareCandy raises a Pokemon's level by one.
+const RareCandy = "rare-candy"
+
+// evolutionCategory is the PokeAPI item category of evolution stones and
+// the other items used to evolve Pokemon.
+const evolutionCategory = "evolution"
+
+// fullHP heals a Pokemon completely.
+const fullHP = -1
+
+// HealingItems is how much HP each healing item restores, using the
+// generation IV amounts.
+var HealingItems = map[string]int{
+	"potion":       20,
+	"super-potion": 50,
+	"hyper-potion": 200,
+	"max-potion":   fullHP,
+	"full-restore": fullHP,
+	"fresh-water":  50,
+	"soda-pop":     60,
+	"lemonade":     80,
+	"moomoo-milk":  100,
+	"oran-berry":   10,
+	"sitrus-berry": 30,
+}
+
+// RevivalItems is the share of its HP each revival item brings a fainted
+// Pokemon back with.
+var RevivalItems = map[string]float64{
+	"revive":     0.5,
+	"max-revive": 1,
+}
+
+type Item struct {
+	Name          string             `json:"name"`
+	Cost          int                `json:"cost"`
+	Category      NamedAPIResource   `json:"category"`
+	Attributes    []NamedAPIResource `json:"attributes"`
+	EffectEntries []VerboseEffect    `json:"effect_entries"`
+	Names         []Name             `json:"names"`
+}
+
+// BerryFlavor is how strongly a berry tastes of one flavor.
+type BerryFlavor struct {
+	Potency int              `json:"potency"`
+	Flavor  NamedAPIResource `json:"flavor"`
+}
+
+// Berry is the farming data for a berry. Berries are named without the
+// "-berry" suffix their items have, e.g. berry "oran" is item "oran-berry".
+type Berry struct {
+	Name             string           `json:"name"`
+	GrowthTime       int              `json:"growth_time"`
+	MaxHarvest       int              `json:"max_harvest"`
+	NaturalGiftPower int              `json:"natural_gift_power"`
+	NaturalGiftType  NamedAPIResource `json:"natural_gift_type"`
+	Size             int              `json:"size"`
+	Smoothness       int              `json:"smoothness"`
+	SoilDryness      int              `json:"soil_dryness"`
+	Firmness         NamedAPIResource `json:"firmness"`
+	Flavors          []BerryFlavor    `json:"flavors"`
+	Item             NamedAPIResource `json:"item"`
+}
+
+func (c *Client) GetItem(name string) (Item, error) {
+	url := c.PokeapiBaseURL + "item/" + name
+	var item Item
+
+	err := c.fetchAndCache(url, &item)
+	if err != nil {
+		return Item{}, err
+	}
+
+	return item, nil
+}
+
+func (c *Client) GetBerry(name string) (Berry, error) {
+	url := c.PokeapiBaseURL + "berry/" + name
+	var berry Berry
+
+	err := c.fetchAndCache(url, &berry)
+	if err != nil {
+		return Berry{}, err
+	}
+
+	return berry, nil
+}
+
+// BerryName returns the berry resource name for an item, and whether the
+// item is a berry at all.
+func BerryName(item string) (string, bool) {
+	return strings.CutSuffix(item, "-berry")
+}
+
+// LocalizedName returns the item's name in language, falling back to
+// DefaultLanguage and then to its PokeAPI name.
+func (i Item) LocalizedName(language string) string {
+	return localizedName(i.Names, language, i.Name)
+}
+
+// EffectText returns the item's short effect in the given language, or ""
+// if PokeAPI has none.
+func (i Item) EffectText(language string) string {
+	return effectText(i.EffectEntries, language)
+}
+
+// FlavorNames lists the flavors the berry has with their potency, e.g.
+// "spicy 10".
+func (b Berry) FlavorNames() []string {
+	var flavors []string
+	for _, f := range b.Flavors {
+		if f.Potency > 0 {
+			flavors = append(flavors, fmt.Sprintf("%s %d", f.Flavor.Name, f.Potency))
+		}
+	}
+	return flavors
+}
+
+// ItemResult is what using an item on a Pokemon did.
+type ItemResult struct {
+	Item  string
+	Entry *CaughtPokemon
+	// Healed is the HP restored by a healing or revival item.
+	Healed int
+	// Levels is the levels gained from a rare candy.
+	Levels int
+	// Evolution is set when the item evolved the Pokemon.
+	Evolution *EvolveResult
+}
+
+// UseItemOn uses one of the trainer's items on a caught Pokemon. Healing
+// items restore HP, revival items bring back a fainted Pokemon, rare candy
+// raises its level by one and evolution items evolve it. The item is only
+// used up if it has an effect.
+func (c *Client) UseItemOn(item string, id int) (ItemResult, error) {
+	cp, _, ok := c.Trainer.Find(id)
+	if !ok {
+		return ItemResult{}, fmt.Errorf("you don't have a Pokemon with ID %d", id)
+	}
+	if c.Trainer.Items[item] <= 0 {
+		return ItemResult{}, fmt.Errorf("you have no %s", item)
+	}
+
+	result := ItemResult{Item: item, Entry: cp}
+	if amount, ok := HealingItems[item]; ok {
+		if cp.Fainted() {
+			return ItemResult{}, fmt.Errorf("%s has fainted and needs a revive", cp.Species)
+		}
+		if cp.Damage == 0 {
+			return ItemResult{}, fmt.Errorf("%s already has full HP", cp.Species)
+		}
+		result.Healed = cp.Heal(amount)
+	} else if share, ok := RevivalItems[item]; ok {
+		if !cp.Fainted() {
+			return ItemResult{}, fmt.Errorf("%s hasn't fainted", cp.Species)
+		}
+		result.Healed = cp.Heal(max(1, int(float64(cp.Stats().HP)*share)))
+	} else if item == RareCandy {
+		if cp.Level >= MaxLevel {
+			return ItemResult{}, fmt.Errorf("%s is already level %d", cp.Species, MaxLevel)
+		}
+		rate, err := c.GetGrowthRate(cp.GrowthRate)
+		if err != nil {
+			return ItemResult{}, err
+		}
+		result.Levels, err = c.AwardExperience(cp, max(0, rate.ExperienceAt(cp.Level+1)-cp.Experience))
+		if err != nil {
+			return ItemResult{}, err
+		}
+	} else {
+		info, err := c.GetItem(item)
+		if err != nil {
+			return ItemResult{}, err
+		}
+		if info.Category.Name != evolutionCategory {
+			return ItemResult{}, fmt.Errorf("%s can't be used on a Pokemon", item)
+		}
+		// Evolve uses up the item itself.
+		evolution, err := c.Evolve(id, EvolveOptions{Item: item})
+		if err != nil {
+			return ItemResult{}, err
+		}
+		result.Evolution = &evolution
+		return result, nil
+	}
+
+	if err := c.Trainer.UseItem(item); err != nil {
+		return ItemResult{}, err
+	}
+	return result, nil
+}
+
+// rollHeldItem picks the item a newly caught Pokemon is holding, if any,
+// using each item's rarity in the client's game version or, with no
+// version set, its highest rarity in any version.
+func (c *Client) rollHeldItem(p Pokemon) string {
+	for _, h := range p.HeldItems {
+		rarity := 0
+		for _, d := range h.VersionDetails {
+			if d.Version.Name == c.Version {
+				rarity = d.Rarity
+				break
+			}
+			if c.Version == "" {
+				rarity = max(rarity, d.Rarity)
+			}
+		}
+		if rarity > 0 && c.Rand.Intn(100) < rarity {
+			return h.Item.Name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,160 @@
+package pokeapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetItem_DecodesDetails(t *testing.T) {
+	client, _ := newTestClient(t)
+
+	item, err := client.GetItem("oran-berry")
+	assert.NoError(t, err)
+	assert.Equal(t, 20, item.Cost)
+	assert.Equal(t, "medicine", item.Category.Name)
+	assert.Equal(t, "Sinelbeere", item.LocalizedName("de"))
+	assert.Equal(t, "oran-berry", item.LocalizedName("fr"))
+	assert.Contains(t, item.EffectText("fr"), "restore 10 HP")
+
+	name, ok := BerryName(item.Name)
+	assert.True(t, ok)
+	berry, err := client.GetBerry(name)
+	assert.NoError(t, err)
+	assert.Equal(t, "poison", berry.NaturalGiftType.Name)
+	assert.Equal(t, "super-hard", berry.Firmness.Name)
+	assert.Equal(t, []string{"bitter 10", "dry 10", "sour 10", "spicy 10"}, berry.FlavorNames())
+
+	_, ok = BerryName("potion")
+	assert.False(t, ok)
+}
+
+func TestUseItemOn_Healing(t *testing.T) {
+	client, _ := newTestClient(t)
+	client.Trainer.AddItem("potion", 2)
+	cp := catchForTest(t, client, "pikachu", 20)
+	maxHP := cp.Stats().HP
+	cp.SetHP(maxHP - 30)
+
+	result, err := client.UseItemOn("potion", cp.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 20, result.Healed)
+	assert.Equal(t, maxHP-10, cp.HP())
+
+	result, err = client.UseItemOn("potion", cp.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, result.Healed)
+	assert.Equal(t, maxHP, cp.HP())
+	assert.Zero(t, client.Trainer.Items["potion"])
+
+	client.Trainer.AddItem("potion", 1)
+	_, err = client.UseItemOn("potion", cp.ID)
+	assert.ErrorContains(t, err, "already has full HP")
+	assert.Equal(t, 1, client.Trainer.Items["potion"])
+}
+
+func TestUseItemOn_Revive(t *testing.T) {
+	client, _ := newTestClient(t)
+	client.Trainer.AddItem("potion", 1)
+	client.Trainer.AddItem("revive", 1)
+	cp := catchForTest(t, client, "pikachu", 20)
+	cp.SetHP(0)
+	assert.True(t, cp.Fainted())
+
+	_, err := client.UseItemOn("potion", cp.ID)
+	assert.ErrorContains(t, err, "needs a revive")
+
+	result, err := client.UseItemOn("revive", cp.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, cp.Stats().HP/2, result.Healed)
+	assert.False(t, cp.Fainted())
+
+	client.Trainer.AddItem("revive", 1)
+	_, err = client.UseItemOn("revive", cp.ID)
+	assert.ErrorContains(t, err, "hasn't fainted")
+}
+
+func TestUseItemOn_RareCandy(t *testing.T) {
+	client, _ := newTestClient(t)
+	client.Trainer.AddItem(RareCandy, 1)
+	cp := catchForTest(t, client, "pikachu", 5)
+	cp.Experience = 125
+
+	result, err := client.UseItemOn(RareCandy, cp.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.Levels)
+	assert.Equal(t, 6, cp.Level)
+	assert.Equal(t, 216, cp.Experience)
+	assert.Zero(t, client.Trainer.Items[RareCandy])
+}
+
+func TestUseItemOn_EvolutionStone(t *testing.T) {
+	client, _ := newTestClient(t)
+	client.Trainer.AddItem("thunder-stone", 1)
+	cp := catchForTest(t, client, "pikachu", 5)
+
+	result, err := client.UseItemOn("thunder-stone", cp.ID)
+	assert.NoError(t, err)
+	if assert.NotNil(t, result.Evolution) {
+		assert.Equal(t, "raichu", result.Evolution.To)
+	}
+	assert.Equal(t, "raichu", cp.Species)
+	assert.Zero(t, client.Trainer.Items["thunder-stone"])
+}
+
+func TestUseItemOn_StoneDoesNotTriggerLevelUp(t *testing.T) {
+	client, _ := newTestClient(t)
+	client.Trainer.AddItem("fire-stone", 1)
+	cp := catchForTest(t, client, "bulbasaur", 16)
+
+	_, err := client.UseItemOn("fire-stone", cp.ID)
+	assert.ErrorContains(t, err, "doesn't evolve with a fire-stone")
+	assert.Equal(t, "bulbasaur", cp.Species)
+	assert.Equal(t, 1, client.Trainer.Items["fire-stone"])
+}
+
+func TestUseItemOn_Errors(t *testing.T) {
+	client, _ := newTestClient(t)
+	client.Trainer.AddItem("fire-stone", 1)
+	cp := catchForTest(t, client, "pikachu", 5)
+
+	_, err := client.UseItemOn("poke-ball", cp.ID)
+	assert.ErrorContains(t, err, "can't be used on a Pokemon")
+
+	_, err = client.UseItemOn("fire-stone", cp.ID)
+	assert.ErrorContains(t, err, "can't evolve")
+	assert.Equal(t, 1, client.Trainer.Items["fire-stone"])
+
+	_, err = client.UseItemOn("potion", cp.ID)
+	assert.ErrorContains(t, err, "you have no potion")
+
+	_, err = client.UseItemOn("fire-stone", 99)
+	assert.ErrorContains(t, err, "a Pokemon with ID 99")
+}
+
+func TestCatchPokemon_RollsHeldItem(t *testing.T) {
+	cases := []struct {
+		name    string
+		roll    int
+		version string
+		held    string
+	}{
+		{"within rarity", 0, "", "poison-barb"},
+		{"above rarity", 7, "", ""},
+		{"version without the item", 0, "platinum", ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			client, _ := newTestClient(t)
+			client.Rand = fixedRand{c.roll}
+			client.Version = c.version
+
+			result, err := client.CatchPokemon("tentacool", CatchOptions{})
+			assert.NoError(t, err)
+			if assert.True(t, result.Caught) {
+				assert.Equal(t, c.held, result.Entry.HeldItem)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package pokeapi
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// MaxNicknameLength is the longest nickname the games allow since
+// generation VI.
+const MaxNicknameLength = 12
+
+// Name is the Pokemon's nickname, or its species if it has none.
+func (cp *CaughtPokemon) Name() string {
+	if cp.Nickname != "" {
+		return cp.Nickname
+	}
+	return cp.Species
+}
+
+func (cp *CaughtPokemon) HasTag(tag string) bool {
+	return slices.Contains(cp.Tags, normalizeTag(tag))
+}
+
+// SetNickname names one of the trainer's Pokemon; an empty name removes
+// its nickname. Nicknames are unique so that they can stand in for IDs.
+func (t *Trainer) SetNickname(id int, nickname string) (*CaughtPokemon, error) {
+	cp, _, ok := t.Find(id)
+	if !ok {
+		return nil, fmt.Errorf("you don't have a Pokemon with ID %d", id)
+	}
+	nickname = strings.TrimSpace(nickname)
+	switch {
+	case len([]rune(nickname)) > MaxNicknameLength:
+		return nil, fmt.Errorf("nicknames can be at most %d characters", MaxNicknameLength)
+	case strings.ContainsFunc(nickname, unicode.IsSpace):
+		return nil, fmt.Errorf("nicknames can't contain spaces")
+	}
+	if _, err := strconv.Atoi(nickname); err == nil {
+		return nil, fmt.Errorf("nicknames can't be numbers, they would look like IDs")
+	}
+	// Species names come first when looking Pokemon up, so a nickname
+	// like that would never be found.
+	if _, ok := t.Dex[strings.ToLower(nickname)]; ok {
+		return nil, fmt.Errorf("%s is a Pokemon species, pick another nickname", nickname)
+	}
+	for _, other := range t.Owned() {
+		if other != cp && nickname != "" && strings.EqualFold(other.Nickname, nickname) {
+			return nil, fmt.Errorf("#%d is already called %s", other.ID, other.Nickname)
+		}
+	}
+	cp.Nickname = nickname
+	return cp, nil
+}
+
+// SetNotes replaces the notes on one of the trainer's Pokemon; empty notes
+// remove them.
+func (t *Trainer) SetNotes(id int, notes string) (*CaughtPokemon, error) {
+	cp, _, ok := t.Find(id)
+	if !ok {
+		return nil, fmt.Errorf("you don't have a Pokemon with ID %d", id)
+	}
+	cp.Notes = strings.TrimSpace(notes)
+	return cp, nil
+}
+
+// AddTags tags one of the trainer's Pokemon. Tags are lowercase and kept
+// sorted; adding a tag twice does nothing.
+func (t *Trainer) AddTags(id int, tags ...string) (*CaughtPokemon, error) {
+	cp, _, ok := t.Find(id)
+	if !ok {
+		return nil, fmt.Errorf("you don't have a Pokemon with ID %d", id)
+	}
+	for _, tag := range tags {
+		tag = normalizeTag(tag)
+		if tag == "" {
+			continue
+		}
+		if _, err := strconv.Atoi(tag); err == nil {
+			return nil, fmt.Errorf("tags can't be numbers, they would look like IDs")
+		}
+		if !slices.Contains(cp.Tags, tag) {
+			cp.Tags = append(cp.Tags, tag)
+		}
+	}
+	slices.Sort(cp.Tags)
+	return cp, nil
+}
+
+// RemoveTags removes tags from one of the trainer's Pokemon.
+func (t *Trainer) RemoveTags(id int, tags ...string) (*CaughtPokemon, error) {
+	cp, _, ok := t.Find(id)
+	if !ok {
+		return nil, fmt.Errorf("you don't have a Pokemon with ID %d", id)
+	}
+	for _, tag := range tags {
+		if !cp.HasTag(tag) {
+			return nil, fmt.Errorf("#%d isn't tagged %s", id, normalizeTag(tag))
+		}
+	}
+	cp.Tags = slices.DeleteFunc(cp.Tags, func(tag string) bool {
+		return slices.ContainsFunc(tags, func(remove string) bool { return normalizeTag(remove) == tag })
+	})
+	return cp, nil
+}
+
+// Tagged lists the trainer's Pokemon with a tag.
+func (t *Trainer) Tagged(tag string) []*CaughtPokemon {
+	var tagged []*CaughtPokemon
+	for _, cp := range t.Owned() {
+		if cp.HasTag(tag) {
+			tagged = append(tagged, cp)
+		}
+	}
+	return tagged
+}
+
+// ambiguousTag explains why name can't stand in for a Pokemon when it is a
+// tag shared by several, and is nil otherwise.
+func (t *Trainer) ambiguousTag(name string) error {
+	tagged := t.Tagged(name)
+	if len(tagged) < 2 {
+		return nil
+	}
+	matches := make([]string, len(tagged))
+	for i, cp := range tagged {
+		matches[i] = fmt.Sprintf("#%d %s", cp.ID, cp.Name())
+	}
+	return fmt.Errorf("%d Pokemon are tagged %s (%s), pick one by ID", len(tagged), normalizeTag(name), strings.Join(matches, ", "))
+}
+
+// TagCounts counts the trainer's Pokemon with each tag.
+func (t *Trainer) TagCounts() map[string]int {
+	counts := make(map[string]int)
+	for _, cp := range t.Owned() {
+		for _, tag := range cp.Tags {
+			counts[tag]++
+		}
+	}
+	return counts
+}
+
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
@@ -0,0 +1,131 @@
+package pokeapi
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/danalytis/pokedexcli/internal/pokecache"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetNickname(t *testing.T) {
+	trainer := NewTrainer()
+	trainer.AddCaught(Pokemon{Name: "pikachu"}, "", time.Now())
+	trainer.AddCaught(Pokemon{Name: "pikachu"}, "", time.Now())
+
+	cp, err := trainer.SetNickname(1, "Sparky")
+	assert.NoError(t, err)
+	assert.Equal(t, "Sparky", cp.Name())
+
+	_, err = trainer.SetNickname(2, "sparky")
+	assert.ErrorContains(t, err, "#1 is already called Sparky")
+	_, err = trainer.SetNickname(2, "42")
+	assert.ErrorContains(t, err, "can't be numbers")
+	_, err = trainer.SetNickname(2, "averyveryverylongname")
+	assert.ErrorContains(t, err, "at most 12")
+	_, err = trainer.SetNickname(3, "bolt")
+	assert.ErrorContains(t, err, "ID 3")
+
+	cp, err = trainer.SetNickname(1, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "pikachu", cp.Name())
+}
+
+func TestTags(t *testing.T) {
+	trainer := NewTrainer()
+	trainer.AddCaught(Pokemon{Name: "pikachu"}, "", time.Now())
+	trainer.AddCaught(Pokemon{Name: "gible"}, "", time.Now())
+
+	cp, err := trainer.AddTags(2, "Competitive", "shiny-hunt", "competitive")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"competitive", "shiny-hunt"}, cp.Tags)
+	_, err = trainer.AddTags(1, "competitive")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"competitive": 2, "shiny-hunt": 1}, trainer.TagCounts())
+
+	_, err = trainer.AddTags(1, "7")
+	assert.ErrorContains(t, err, "can't be numbers")
+
+	_, err = trainer.RemoveTags(2, "breeding")
+	assert.ErrorContains(t, err, "isn't tagged breeding")
+	cp, err = trainer.RemoveTags(2, "COMPETITIVE")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"shiny-hunt"}, cp.Tags)
+}
+
+func TestLookup_NicknameAndTag(t *testing.T) {
+	trainer := NewTrainer()
+	trainer.AddCaught(Pokemon{Name: "pikachu"}, "", time.Now())
+	trainer.AddCaught(Pokemon{Name: "gible"}, "", time.Now())
+	trainer.AddCaught(Pokemon{Name: "bulbasaur"}, "", time.Now())
+	trainer.See("mewtwo")
+
+	_, err := trainer.SetNickname(2, "Pikachu")
+	assert.ErrorContains(t, err, "is a Pokemon species")
+	_, err = trainer.SetNickname(2, "mewtwo")
+	assert.ErrorContains(t, err, "is a Pokemon species")
+	_, err = trainer.SetNickname(2, "chomp")
+	assert.NoError(t, err)
+
+	p, ok := trainer.Lookup("pikachu")
+	assert.True(t, ok)
+	assert.Equal(t, 1, p.ID)
+	p, ok = trainer.Lookup("Chomp")
+	assert.True(t, ok)
+	assert.Equal(t, 2, p.ID)
+
+	trainer.AddTags(1, "starter")
+	p, ok = trainer.Lookup("starter")
+	assert.True(t, ok)
+	assert.Equal(t, 1, p.ID)
+	assert.NoError(t, trainer.ambiguousTag("starter"))
+
+	trainer.AddTags(2, "starter")
+	_, ok = trainer.Lookup("starter")
+	assert.False(t, ok, "a shared tag picks no Pokemon")
+	assert.EqualError(t, trainer.ambiguousTag("starter"),
+		"2 Pokemon are tagged starter (#1 pikachu, #2 chomp), pick one by ID")
+}
+
+func TestQueryPokedex_TagNoteAndNickname(t *testing.T) {
+	client, _ := newTestClient(t)
+	pikachu := catchForTest(t, client, "pikachu", 5)
+	gible := catchForTest(t, client, "gible", 5)
+	client.Trainer.SetNickname(gible.ID, "chomp")
+	client.Trainer.AddTags(pikachu.ID, "competitive")
+	client.Trainer.SetNotes(gible.ID, "Evolve at level 24")
+
+	for _, c := range []struct {
+		query DexQuery
+		want  *CaughtPokemon
+	}{
+		{DexQuery{Tag: "competitive"}, pikachu},
+		{DexQuery{Note: "evolve"}, gible},
+		{DexQuery{Name: "ch*"}, gible},
+	} {
+		entries, err := client.QueryPokedex(c.query)
+		assert.NoError(t, err)
+		if assert.Len(t, entries, 1, "%+v", c.query) {
+			assert.Equal(t, c.want, entries[0].CaughtPokemon)
+		}
+	}
+}
+
+func TestSaveState_KeepsLabels(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+	cache := pokecache.NewCache(5 * time.Minute)
+	client := NewClient(&cache)
+	client.Trainer.AddCaught(Pokemon{Name: "pikachu"}, "", time.Now())
+	client.Trainer.SetNickname(1, "sparky")
+	client.Trainer.SetNotes(1, "first catch")
+	client.Trainer.AddTags(1, "starter")
+	assert.NoError(t, client.SaveState(path))
+
+	restored := NewClient(&cache)
+	assert.NoError(t, restored.LoadState(path))
+	cp := restored.Trainer.Party[0]
+	assert.Equal(t, "sparky", cp.Nickname)
+	assert.Equal(t, "first catch", cp.Notes)
+	assert.Equal(t, []string{"starter"}, cp.Tags)
+}
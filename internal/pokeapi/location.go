@@ -0,0 +1,138 @@
+package pokeapi
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var ErrDifferentRegion = errors.New("that area is in another region")
+
+// Location is a place on the map, such as a city or cave, made up of one
+// or more location areas.
+type Location struct {
+	Name   string             `json:"name"`
+	Region NamedAPIResource   `json:"region"`
+	Areas  []NamedAPIResource `json:"areas"`
+}
+
+// Region is a part of the Pokemon world, such as Kanto or Sinnoh.
+type Region struct {
+	Name           string             `json:"name"`
+	Locations      []NamedAPIResource `json:"locations"`
+	Pokedexes      []NamedAPIResource `json:"pokedexes"`
+	MainGeneration *NamedAPIResource  `json:"main_generation"`
+}
+
+// Position is where the trainer is standing: a location area together with
+// its parent location and region.
+type Position struct {
+	Area     string `json:"area"`
+	Location string `json:"location"`
+	Region   string `json:"region"`
+}
+
+func (p Position) String() string {
+	if p.Area == "" {
+		return "nowhere yet"
+	}
+	s := p.Area
+	if p.Location != "" {
+		s += " in " + p.Location
+	}
+	if p.Region != "" {
+		s += ", " + p.Region
+	}
+	return s
+}
+
+// GotoOptions controls travel restrictions.
+type GotoOptions struct {
+	// AnyRegion allows travel to an area outside the current region.
+	AnyRegion bool
+}
+
+func (c *Client) GetLocation(name string) (Location, error) {
+	url := c.PokeapiBaseURL + "location/" + name
+	var location Location
+
+	err := c.fetchAndCache(url, &location)
+	if err != nil {
+		return Location{}, err
+	}
+
+	return location, nil
+}
+
+func (c *Client) GetRegion(name string) (Region, error) {
+	url := c.PokeapiBaseURL + "region/" + name
+	var region Region
+
+	err := c.fetchAndCache(url, &region)
+	if err != nil {
+		return Region{}, err
+	}
+
+	return region, nil
+}
+
+// Locate finds the location and region a location area belongs to.
+func (c *Client) Locate(area string) (Position, error) {
+	resp, err := c.ExploreLocation(area)
+	if err != nil {
+		return Position{}, err
+	}
+
+	position := Position{Area: area, Location: resp.Location.Name}
+	if position.Location == "" {
+		return position, nil
+	}
+	location, err := c.GetLocation(position.Location)
+	if err != nil {
+		return Position{}, err
+	}
+	position.Region = location.Region.Name
+	return position, nil
+}
+
+// Goto moves the trainer to a location area. Travel is limited to the
+// current region unless opts allow otherwise.
+func (c *Client) Goto(area string, opts GotoOptions) (Position, error) {
+	position, err := c.Locate(area)
+	if err != nil {
+		return Position{}, err
+	}
+
+	current := c.Trainer.Position.Region
+	if !opts.AnyRegion && current != "" && position.Region != "" && position.Region != current {
+		return Position{}, fmt.Errorf("%w: %s is in %s, but you are in %s", ErrDifferentRegion, area, position.Region, current)
+	}
+
+	c.Trainer.Position = position
+	return position, nil
+}
+
+// CheckCatchable returns an error unless the named Pokemon can be found in
+// the trainer's current area, to catch or battle. Any Pokemon can be found
+// in free-catch mode.
+func (c *Client) CheckCatchable(name string) error {
+	if c.Trainer.FreeCatch {
+		return nil
+	}
+	area := c.Trainer.Position.Area
+	if area == "" {
+		return fmt.Errorf("you need to go to a location area to find wild Pokemon")
+	}
+
+	resp, err := c.ExploreLocation(area)
+	if err != nil {
+		return err
+	}
+	found := slices.ContainsFunc(resp.PokemonEncounters, func(e LocationPokemonEncounter) bool {
+		return e.Pokemon.Name == name
+	})
+	if !found {
+		return fmt.Errorf("there are no wild %s in %s", name, area)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package pokeapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocate(t *testing.T) {
+	client, _ := newTestClient(t)
+
+	position, err := client.Locate("mt-coronet-1f-route-207")
+	assert.NoError(t, err)
+	assert.Equal(t, Position{Area: "mt-coronet-1f-route-207", Location: "mt-coronet", Region: "sinnoh"}, position)
+	assert.Equal(t, "mt-coronet-1f-route-207 in mt-coronet, sinnoh", position.String())
+
+	_, err = client.Locate("atlantis")
+	assert.Error(t, err)
+}
+
+func TestGoto_StaysInRegion(t *testing.T) {
+	client, _ := newTestClient(t)
+
+	_, err := client.Goto("canalave-city-area", GotoOptions{})
+	assert.NoError(t, err)
+
+	_, err = client.Goto("cerulean-cave-b1f", GotoOptions{})
+	assert.ErrorIs(t, err, ErrDifferentRegion)
+	assert.Equal(t, "canalave-city-area", client.Trainer.Position.Area)
+
+	position, err := client.Goto("cerulean-cave-b1f", GotoOptions{AnyRegion: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "kanto", position.Region)
+	assert.Equal(t, position, client.Trainer.Position)
+}
+
+func TestCheckCatchable(t *testing.T) {
+	client, _ := newTestClient(t)
+	assert.ErrorContains(t, client.CheckCatchable("tentacool"), "go to a location area")
+
+	_, err := client.Goto("canalave-city-area", GotoOptions{})
+	assert.NoError(t, err)
+	assert.NoError(t, client.CheckCatchable("tentacool"))
+	assert.ErrorContains(t, client.CheckCatchable("mewtwo"), "no wild mewtwo in canalave-city-area")
+
+	client.Trainer.FreeCatch = true
+	assert.NoError(t, client.CheckCatchable("mewtwo"))
+}
@@ -0,0 +1,149 @@
+package pokeapi
+
+import (
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// LearnMethods are the common ways a Pokemon learns moves, in the order
+// learnsets list them. Other methods follow, alphabetically.
+var LearnMethods = []string{"level-up", "machine", "egg", "tutor"}
+
+// Move is a move resource. Power and Accuracy are nil for moves that do not
+// deal damage or never miss.
+type Move struct {
+	Name          string           `json:"name"`
+	Power         *int             `json:"power"`
+	Accuracy      *int             `json:"accuracy"`
+	PP            int              `json:"pp"`
+	Priority      int              `json:"priority"`
+	Type          NamedAPIResource `json:"type"`
+	DamageClass   NamedAPIResource `json:"damage_class"`
+	EffectChance  *int             `json:"effect_chance"`
+	EffectEntries []VerboseEffect  `json:"effect_entries"`
+}
+
+// VerboseEffect is an effect description in one language.
+type VerboseEffect struct {
+	Effect      string           `json:"effect"`
+	ShortEffect string           `json:"short_effect"`
+	Language    NamedAPIResource `json:"language"`
+}
+
+// MoveVersionDetail is how a Pokemon learns a move in one version group.
+// LevelLearnedAt is zero for methods other than level-up.
+type MoveVersionDetail struct {
+	LevelLearnedAt  int              `json:"level_learned_at"`
+	MoveLearnMethod NamedAPIResource `json:"move_learn_method"`
+	VersionGroup    NamedAPIResource `json:"version_group"`
+}
+
+// LearnableMove is a move in a learnset.
+type LearnableMove struct {
+	Move   string
+	Method string
+	Level  int
+}
+
+func (c *Client) GetMove(name string) (Move, error) {
+	url := c.PokeapiBaseURL + "move/" + name
+	var move Move
+
+	err := c.fetchAndCache(url, &move)
+	if err != nil {
+		return Move{}, err
+	}
+
+	return move, nil
+}
+
+// EffectText returns the move's short effect in the given language, with
+// its effect chance filled in, or "" if PokeAPI has none.
+func (m Move) EffectText(language string) string {
+	text := effectText(m.EffectEntries, language)
+	if m.EffectChance != nil {
+		text = strings.ReplaceAll(text, "$effect_chance", strconv.Itoa(*m.EffectChance))
+	}
+	return text
+}
+
+// effectText picks the short effect in a language, or the full effect if
+// there is no short one. PokeAPI has most effects only in English, which
+// is used when the language has none.
+func effectText(entries []VerboseEffect, language string) string {
+	for _, lang := range []string{language, DefaultLanguage} {
+		for _, e := range entries {
+			if !strings.EqualFold(e.Language.Name, lang) {
+				continue
+			}
+			if e.ShortEffect != "" {
+				return e.ShortEffect
+			}
+			return e.Effect
+		}
+	}
+	return ""
+}
+
+// VersionGroups lists the version groups the Pokemon has learnset data for,
+// most moves first.
+func (p Pokemon) VersionGroups() []string {
+	counts := make(map[string]int)
+	for _, pm := range p.Moves {
+		for _, d := range pm.VersionGroupDetails {
+			counts[d.VersionGroup.Name]++
+		}
+	}
+	groups := make([]string, 0, len(counts))
+	for group := range counts {
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if counts[groups[i]] != counts[groups[j]] {
+			return counts[groups[i]] > counts[groups[j]]
+		}
+		return groups[i] < groups[j]
+	})
+	return groups
+}
+
+// Learnset lists the moves the Pokemon learns in a version group, grouped
+// by LearnMethods order, level-up moves by level and the rest by name.
+func (p Pokemon) Learnset(versionGroup string) []LearnableMove {
+	var learnset []LearnableMove
+	for _, pm := range p.Moves {
+		for _, d := range pm.VersionGroupDetails {
+			if d.VersionGroup.Name != versionGroup {
+				continue
+			}
+			learnset = append(learnset, LearnableMove{
+				Move:   pm.Move.Name,
+				Method: d.MoveLearnMethod.Name,
+				Level:  d.LevelLearnedAt,
+			})
+		}
+	}
+
+	rank := func(method string) int {
+		if i := slices.Index(LearnMethods, method); i >= 0 {
+			return i
+		}
+		return len(LearnMethods)
+	}
+	sort.Slice(learnset, func(i, j int) bool {
+		a, b := learnset[i], learnset[j]
+		if rank(a.Method) != rank(b.Method) {
+			return rank(a.Method) < rank(b.Method)
+		}
+		if a.Method != b.Method {
+			return a.Method < b.Method
+		}
+		if a.Level != b.Level {
+			return a.Level < b.Level
+		}
+		return a.Move < b.Move
+	})
+	return learnset
+}
@@ -0,0 +1,34 @@
+package pokeapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLearnset_GroupsByMethod(t *testing.T) {
+	client, _ := newTestClient(t)
+	p, err := client.GetPokemon("pikachu")
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"diamond-pearl", "red-blue"}, p.VersionGroups())
+	assert.Equal(t, []LearnableMove{
+		{Move: "thunder-shock", Method: "level-up", Level: 1},
+		{Move: "thunder-wave", Method: "level-up", Level: 8},
+		{Move: "quick-attack", Method: "level-up", Level: 13},
+		{Move: "thunderbolt", Method: "machine"},
+	}, p.Learnset("diamond-pearl"))
+	assert.Empty(t, p.Learnset("x-y"))
+}
+
+func TestMove_EffectText(t *testing.T) {
+	client, _ := newTestClient(t)
+
+	move, err := client.GetMove("thunderbolt")
+	assert.NoError(t, err)
+	assert.Equal(t, "Has a 10% chance to paralyze the target.", move.EffectText("en"))
+	assert.Equal(t, "Has a 10% chance to paralyze the target.", move.EffectText("fr"))
+
+	splash := Move{Name: "splash"}
+	assert.Equal(t, "", splash.EffectText("en"))
+}
@@ -0,0 +1,138 @@
+package pokeapi
+
+import (
+	"strings"
+)
+
+// DefaultLanguage is the language used when text isn't available in the
+// one asked for.
+const DefaultLanguage = "en"
+
+type PokemonSpecies struct {
+	Name              string                  `json:"name"`
+	CaptureRate       int                     `json:"capture_rate"`
+	BaseHappiness     int                     `json:"base_happiness"`
+	GrowthRate        NamedAPIResource        `json:"growth_rate"`
+	EvolutionChain    APIResource             `json:"evolution_chain"`
+	Varieties         []PokemonSpeciesVariety `json:"varieties"`
+	Names             []Name                  `json:"names"`
+	Genera            []Genus                 `json:"genera"`
+	FlavorTextEntries []FlavorText            `json:"flavor_text_entries"`
+}
+
+// PokemonSpeciesVariety is one of the Pokemon, such as a form, that belong
+// to a species.
+type PokemonSpeciesVariety struct {
+	IsDefault bool             `json:"is_default"`
+	Pokemon   NamedAPIResource `json:"pokemon"`
+}
+
+// Name is a resource's name in one language.
+type Name struct {
+	Name     string           `json:"name"`
+	Language NamedAPIResource `json:"language"`
+}
+
+// Genus is a species' category, such as "Mouse Pokemon", in one language.
+type Genus struct {
+	Genus    string           `json:"genus"`
+	Language NamedAPIResource `json:"language"`
+}
+
+// FlavorText is a species' Pokedex entry in one game version and language.
+type FlavorText struct {
+	FlavorText string           `json:"flavor_text"`
+	Language   NamedAPIResource `json:"language"`
+	Version    NamedAPIResource `json:"version"`
+}
+
+func (c *Client) GetPokemonSpecies(name string) (PokemonSpecies, error) {
+	url := c.PokeapiBaseURL + "pokemon-species/" + name
+	var species PokemonSpecies
+
+	err := c.fetchAndCache(url, &species)
+	if err != nil {
+		return PokemonSpecies{}, err
+	}
+
+	return species, nil
+}
+
+// DefaultPokemon names the species' usual form. For most species that is
+// the species name, but e.g. wormadam's is wormadam-plant.
+func (s PokemonSpecies) DefaultPokemon() string {
+	for _, v := range s.Varieties {
+		if v.IsDefault {
+			return v.Pokemon.Name
+		}
+	}
+	return s.Name
+}
+
+// SpeciesName is the species the Pokemon belongs to, which differs from
+// its own name for forms such as deoxys-normal.
+func (p Pokemon) SpeciesName() string {
+	if p.Species.Name != "" {
+		return p.Species.Name
+	}
+	return p.Name
+}
+
+// LocalizedName returns the species' name in language, falling back to
+// DefaultLanguage and then to its PokeAPI name.
+func (s PokemonSpecies) LocalizedName(language string) string {
+	return localizedName(s.Names, language, s.Name)
+}
+
+// localizedName picks the name in language. Language codes are compared
+// without case, as PokeAPI has some such as ja-Hrkt and zh-Hant.
+func localizedName(names []Name, language, fallback string) string {
+	for _, lang := range []string{language, DefaultLanguage} {
+		for _, n := range names {
+			if strings.EqualFold(n.Language.Name, lang) {
+				return n.Name
+			}
+		}
+	}
+	return fallback
+}
+
+// GenusIn returns the species' genus in language, falling back to
+// DefaultLanguage, or "" if it has none.
+func (s PokemonSpecies) GenusIn(language string) string {
+	for _, lang := range []string{language, DefaultLanguage} {
+		for _, g := range s.Genera {
+			if strings.EqualFold(g.Language.Name, lang) {
+				return g.Genus
+			}
+		}
+	}
+	return ""
+}
+
+// FlavorTextIn returns the species' Pokedex entry in language from the
+// given game version, or from the latest version with one if version is
+// empty. It reports the version the entry came from, and falls back to
+// DefaultLanguage when language has no entry.
+func (s PokemonSpecies) FlavorTextIn(language, version string) (string, string) {
+	for _, lang := range []string{language, DefaultLanguage} {
+		var found *FlavorText
+		for i, f := range s.FlavorTextEntries {
+			if !strings.EqualFold(f.Language.Name, lang) || (version != "" && f.Version.Name != version) {
+				continue
+			}
+			found = &s.FlavorTextEntries[i]
+		}
+		if found != nil {
+			return cleanFlavorText(found.FlavorText), found.Version.Name
+		}
+	}
+	return "", ""
+}
+
+// cleanFlavorText joins the line and page breaks PokeAPI keeps from the
+// games' text boxes into single spaces.
+func cleanFlavorText(text string) string {
+	text = strings.ReplaceAll(text, "\u00ad\n", "")
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,46 @@
+package pokeapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpecies_LocalizedText(t *testing.T) {
+	client, _ := newTestClient(t)
+	species, err := client.GetPokemonSpecies("gible")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Kaumalat", species.LocalizedName("de"))
+	assert.Equal(t, "フカマル", species.LocalizedName("ja"))
+	assert.Equal(t, "Gible", species.LocalizedName("ko"))
+	assert.Equal(t, "Landhai", species.GenusIn("de"))
+	assert.Equal(t, "Land Shark Pokémon", species.GenusIn("fr"))
+
+	// PokeAPI spells some codes in mixed case; typed ones are lowercased.
+	assert.Equal(t, "圓陸鯊", species.LocalizedName("zh-hant"))
+	assert.Equal(t, "陸鯊寶可夢", species.GenusIn("zh-hant"))
+	text, version := species.FlavorTextIn("zh-hant", "")
+	assert.Equal(t, "sword", version)
+	assert.Equal(t, "棲息在洞窟牆壁上的橫洞裡。 一旦有獵物靠近， 就會跳出來撲上去。", text)
+}
+
+func TestSpecies_FlavorTextIn(t *testing.T) {
+	client, _ := newTestClient(t)
+	species, err := client.GetPokemonSpecies("pikachu")
+	assert.NoError(t, err)
+
+	text, version := species.FlavorTextIn("en", "")
+	assert.Equal(t, "diamond", version)
+	assert.Equal(t, "It lives in forests with others. It stores electricity in the pouches on its cheeks.", text)
+
+	text, version = species.FlavorTextIn("en", "red")
+	assert.Equal(t, "red", version)
+	assert.Equal(t, "When several of these POKéMON gather, their electricity could build and cause lightning storms.", text)
+
+	text, _ = species.FlavorTextIn("ja", "red")
+	assert.Contains(t, text, "POKéMON")
+
+	text, _ = species.FlavorTextIn("en", "platinum")
+	assert.Equal(t, "", text)
+}
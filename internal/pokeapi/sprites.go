@@ -0,0 +1,44 @@
+package pokeapi
+
+import (
+	"fmt"
+)
+
+// GetSprite downloads a sprite image, caching it like any other response.
+func (c *Client) GetSprite(url string) ([]byte, error) {
+	return c.fetch(url)
+}
+
+// SpriteFor returns the front sprite of a caught or seen Pokemon, by name
+// or ID, or its shiny variant.
+func (c *Client) SpriteFor(nameOrID string, shiny bool) ([]byte, error) {
+	name := nameOrID
+	var pokemon Pokemon
+	if caught, ok := c.Trainer.Lookup(nameOrID); ok {
+		// Forms such as wormadam-plant have no Pokemon named after their
+		// species, so the sprites come from the caught Pokemon itself.
+		full, err := c.FullPokemon(caught)
+		if err != nil {
+			return nil, err
+		}
+		name, pokemon = caught.Species, full
+	} else if err := c.Trainer.ambiguousTag(name); err != nil {
+		return nil, err
+	} else {
+		species, err := c.speciesOf(name)
+		if err != nil || c.Trainer.Status(species) == "" {
+			return nil, fmt.Errorf("you haven't seen %s yet", name)
+		}
+		if pokemon, err = c.GetPokemon(name); err != nil {
+			return nil, err
+		}
+	}
+	url, variant := pokemon.Sprites.FrontDefault, "sprite"
+	if shiny {
+		url, variant = pokemon.Sprites.FrontShiny, "shiny sprite"
+	}
+	if url == nil {
+		return nil, fmt.Errorf("%s has no %s", name, variant)
+	}
+	return c.GetSprite(*url)
+}
@@ -0,0 +1,56 @@
+package pokeapi
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpriteFor_CaughtSeenAndUnseen(t *testing.T) {
+	client, server := newTestClient(t)
+
+	_, err := client.SpriteFor("gible", false)
+	assert.ErrorContains(t, err, "haven't seen gible")
+
+	client.Trainer.See("gible")
+	data, err := client.SpriteFor("gible", false)
+	assert.NoError(t, err)
+	_, err = png.Decode(bytes.NewReader(data))
+	assert.NoError(t, err)
+
+	p, err := client.GetPokemon("pikachu")
+	assert.NoError(t, err)
+	caught, _, err := client.Trainer.AddCaught(p, "", time.Now())
+	assert.NoError(t, err)
+	shiny, err := client.SpriteFor("1", true)
+	assert.NoError(t, err)
+	plain, err := client.SpriteFor("1", false)
+	assert.NoError(t, err)
+	assert.NotEqual(t, plain, shiny)
+	assert.Equal(t, "pikachu", caught.Species)
+
+	// Sprites are cached like JSON responses.
+	_, err = client.SpriteFor("pikachu", false)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, server.Requests("sprites/pokemon/25.png"))
+}
+
+func TestSpriteFor_Forms(t *testing.T) {
+	client, server := newTestClient(t)
+	catchForTest(t, client, "wormadam-plant", 5)
+	client.Trainer.See("burmy")
+
+	_, err := client.SpriteFor("1", false)
+	assert.NoError(t, err)
+	_, err = client.SpriteFor("wormadam", false)
+	assert.NoError(t, err)
+	_, err = client.SpriteFor("wormadam-plant", true)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, server.Requests("sprites/pokemon/413.png"))
+
+	_, err = client.SpriteFor("burmy-sandy", false)
+	assert.EqualError(t, err, "you haven't seen burmy-sandy yet")
+}
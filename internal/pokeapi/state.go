@@ -0,0 +1,62 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// savedState is everything about the player that outlives a session.
+type savedState struct {
+	Trainer *Trainer `json:"trainer"`
+}
+
+// SaveState writes the trainer's inventory and caught Pokemon to path as
+// JSON.
+func (c *Client) SaveState(path string) error {
+	data, err := json.MarshalIndent(savedState{
+		Trainer: c.Trainer,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding save file: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("error creating save directory: %w", err)
+	}
+
+	// Write to a temporary file first so a crash never leaves a truncated
+	// save behind.
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("error writing save file: %w", err)
+	}
+	return os.Rename(tmp, path)
+}
+
+// LoadState restores the trainer's inventory and caught Pokemon from path.
+// A missing file is not an error; the client keeps its fresh state.
+func (c *Client) LoadState(path string) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading save file: %w", err)
+	}
+
+	var state savedState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return fmt.Errorf("error decoding save file: %w", err)
+	}
+
+	if state.Trainer != nil {
+		if state.Trainer.Items == nil {
+			state.Trainer.Items = make(map[string]int)
+		}
+		c.Trainer = state.Trainer
+	}
+	return nil
+}
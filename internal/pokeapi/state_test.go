@@ -0,0 +1,79 @@
+package pokeapi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/danalytis/pokedexcli/internal/pokecache"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveState_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "save.json")
+
+	cache := pokecache.NewCache(5 * time.Minute)
+	client := NewClient(&cache)
+	client.Trainer.AddCaught(Pokemon{Name: "pikachu", Height: 4, Weight: 60}, "pallet-town-area", time.Now().UTC())
+	client.Trainer.Money = 1234
+	client.Trainer.Items["ultra-ball"] = 2
+
+	assert.NoError(t, client.SaveState(path))
+
+	restored := NewClient(&cache)
+	assert.NoError(t, restored.LoadState(path))
+	assert.Equal(t, client.Trainer.Party[0].Location, restored.Trainer.Party[0].Location)
+	assert.True(t, client.Trainer.Party[0].CaughtAt.Equal(restored.Trainer.Party[0].CaughtAt))
+	assert.Equal(t, client.Trainer.Party[0].Pokemon, restored.Trainer.Party[0].Pokemon)
+	assert.Equal(t, client.Trainer.Money, restored.Trainer.Money)
+	assert.Equal(t, client.Trainer.Items, restored.Trainer.Items)
+	assert.Equal(t, client.Trainer.NextID, restored.Trainer.NextID)
+	assert.Len(t, restored.Trainer.Boxes, NumBoxes)
+}
+
+func TestSaveState_RefetchesMoves(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+	client, server := newTestClient(t)
+	caught := catchForTest(t, client, "pikachu", 5)
+	assert.NotEmpty(t, caught.Pokemon.Moves)
+	assert.NoError(t, client.SaveState(path))
+
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), `"moves"`)
+	assert.NotContains(t, string(data), `"sprites"`)
+
+	cache := pokecache.NewCache(5 * time.Minute)
+	restored := NewClientWithBaseURL(&cache, server.BaseURL())
+	assert.NoError(t, restored.LoadState(path))
+	cp := restored.Trainer.Party[0]
+	assert.Nil(t, cp.Pokemon.Moves)
+	assert.Equal(t, caught.Pokemon.Types, cp.Pokemon.Types)
+	assert.Equal(t, caught.Stats(), cp.Stats())
+
+	full, err := restored.FullPokemon(cp)
+	assert.NoError(t, err)
+	assert.Equal(t, caught.Pokemon.Moves, full.Moves)
+	assert.Equal(t, full, cp.Pokemon)
+}
+
+func TestLoadState_MissingFile(t *testing.T) {
+	cache := pokecache.NewCache(5 * time.Minute)
+	client := NewClient(&cache)
+
+	err := client.LoadState(filepath.Join(t.TempDir(), "missing.json"))
+	assert.NoError(t, err)
+	assert.Equal(t, startingMoney, client.Trainer.Money)
+}
+
+func TestLoadState_Corrupt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+	assert.NoError(t, os.WriteFile(path, []byte("{not json"), 0o644))
+
+	cache := pokecache.NewCache(5 * time.Minute)
+	client := NewClient(&cache)
+
+	err := client.LoadState(path)
+	assert.ErrorContains(t, err, "error decoding save file")
+}
@@ -0,0 +1,299 @@
+package pokeapi
+
+import (
+	"fmt"
+)
+
+const (
+	MaxLevel = 100
+	MaxIV    = 31
+	MaxEV    = 252
+	MaxEVs   = 510
+	// DefaultCatchLevel is the level of a caught Pokemon when the encounter
+	// does not say otherwise.
+	DefaultCatchLevel = 5
+
+	MaxFriendship = 255
+	// friendshipPerLevel is how much friendship a Pokemon gains each time
+	// it levels up.
+	friendshipPerLevel = 5
+)
+
+// StatNames are the six stats in the order PokeAPI lists them.
+var StatNames = []string{"hp", "attack", "defense", "special-attack", "special-defense", "speed"}
+
+// StatSet holds one value per stat, e.g. base stats, IVs or EVs.
+type StatSet struct {
+	HP             int `json:"hp"`
+	Attack         int `json:"attack"`
+	Defense        int `json:"defense"`
+	SpecialAttack  int `json:"special_attack"`
+	SpecialDefense int `json:"special_defense"`
+	Speed          int `json:"speed"`
+}
+
+// Get returns a stat by its PokeAPI name.
+func (s StatSet) Get(name string) int {
+	switch name {
+	case "hp":
+		return s.HP
+	case "attack":
+		return s.Attack
+	case "defense":
+		return s.Defense
+	case "special-attack":
+		return s.SpecialAttack
+	case "special-defense":
+		return s.SpecialDefense
+	case "speed":
+		return s.Speed
+	}
+	return 0
+}
+
+// Set changes a stat by its PokeAPI name.
+func (s *StatSet) Set(name string, value int) {
+	switch name {
+	case "hp":
+		s.HP = value
+	case "attack":
+		s.Attack = value
+	case "defense":
+		s.Defense = value
+	case "special-attack":
+		s.SpecialAttack = value
+	case "special-defense":
+		s.SpecialDefense = value
+	case "speed":
+		s.Speed = value
+	}
+}
+
+func (s StatSet) Total() int {
+	return s.HP + s.Attack + s.Defense + s.SpecialAttack + s.SpecialDefense + s.Speed
+}
+
+// BaseStats reads a Pokemon's base stats by name.
+func BaseStats(p Pokemon) StatSet {
+	return readStats(p, func(s Stat) int { return s.BaseStat })
+}
+
+// EffortYield is the EVs earned for defeating a Pokemon.
+func EffortYield(p Pokemon) StatSet {
+	return readStats(p, func(s Stat) int { return s.Effort })
+}
+
+func readStats(p Pokemon, value func(Stat) int) StatSet {
+	var stats StatSet
+	for _, stat := range p.Stats {
+		stats.Set(stat.Stat.Name, value(stat))
+	}
+	return stats
+}
+
+// Nature raises one stat by 10% and lowers another by 10%. Neutral natures
+// raise and lower the same stat.
+type Nature struct {
+	Name      string
+	Increased string
+	Decreased string
+}
+
+var Natures = []Nature{
+	{"hardy", "attack", "attack"},
+	{"lonely", "attack", "defense"},
+	{"brave", "attack", "speed"},
+	{"adamant", "attack", "special-attack"},
+	{"naughty", "attack", "special-defense"},
+	{"bold", "defense", "attack"},
+	{"docile", "defense", "defense"},
+	{"relaxed", "defense", "speed"},
+	{"impish", "defense", "special-attack"},
+	{"lax", "defense", "special-defense"},
+	{"timid", "speed", "attack"},
+	{"hasty", "speed", "defense"},
+	{"serious", "speed", "speed"},
+	{"jolly", "speed", "special-attack"},
+	{"naive", "speed", "special-defense"},
+	{"modest", "special-attack", "attack"},
+	{"mild", "special-attack", "defense"},
+	{"quiet", "special-attack", "speed"},
+	{"bashful", "special-attack", "special-attack"},
+	{"rash", "special-attack", "special-defense"},
+	{"calm", "special-defense", "attack"},
+	{"gentle", "special-defense", "defense"},
+	{"sassy", "special-defense", "speed"},
+	{"careful", "special-defense", "special-attack"},
+	{"quirky", "special-defense", "special-defense"},
+}
+
+// NatureByName returns the named nature, or hardy if it is unknown.
+func NatureByName(name string) Nature {
+	for _, n := range Natures {
+		if n.Name == name {
+			return n
+		}
+	}
+	return Natures[0]
+}
+
+// CalculateStats applies the mainline stat formula to base stats, IVs and
+// EVs at a level, then the nature's modifiers.
+func CalculateStats(base, ivs, evs StatSet, level int, nature Nature) StatSet {
+	var stats StatSet
+	for _, name := range StatNames {
+		core := (2*base.Get(name) + ivs.Get(name) + evs.Get(name)/4) * level / 100
+		if name == "hp" {
+			stats.Set(name, core+level+10)
+			continue
+		}
+
+		value := core + 5
+		if nature.Increased != nature.Decreased {
+			switch name {
+			case nature.Increased:
+				value = value * 110 / 100
+			case nature.Decreased:
+				value = value * 90 / 100
+			}
+		}
+		stats.Set(name, value)
+	}
+	return stats
+}
+
+// Stats returns the caught Pokemon's actual stats at its current level.
+func (cp *CaughtPokemon) Stats() StatSet {
+	return CalculateStats(BaseStats(cp.Pokemon), cp.IVs, cp.EVs, cp.Level, NatureByName(cp.Nature))
+}
+
+type GrowthRateLevel struct {
+	Level      int `json:"level"`
+	Experience int `json:"experience"`
+}
+
+// GrowthRate is the experience curve shared by a group of species.
+type GrowthRate struct {
+	Name   string            `json:"name"`
+	Levels []GrowthRateLevel `json:"levels"`
+}
+
+func (c *Client) GetGrowthRate(name string) (GrowthRate, error) {
+	url := c.PokeapiBaseURL + "growth-rate/" + name
+	var rate GrowthRate
+
+	err := c.fetchAndCache(url, &rate)
+	if err != nil {
+		return GrowthRate{}, err
+	}
+
+	return rate, nil
+}
+
+// ExperienceAt returns the total experience needed to reach level.
+func (g GrowthRate) ExperienceAt(level int) int {
+	for _, l := range g.Levels {
+		if l.Level == level {
+			return l.Experience
+		}
+	}
+	return 0
+}
+
+// LevelFor returns the highest level reached with the given total
+// experience.
+func (g GrowthRate) LevelFor(experience int) int {
+	level := 1
+	for _, l := range g.Levels {
+		if l.Experience <= experience && l.Level > level {
+			level = l.Level
+		}
+	}
+	return min(level, MaxLevel)
+}
+
+// rollIVs draws each IV uniformly from 0 to MaxIV.
+func (c *Client) rollIVs() StatSet {
+	var ivs StatSet
+	for _, name := range StatNames {
+		ivs.Set(name, c.Rand.Intn(MaxIV+1))
+	}
+	return ivs
+}
+
+func (c *Client) rollNature() string {
+	return Natures[c.Rand.Intn(len(Natures))].Name
+}
+
+// AwardExperience adds experience to a caught Pokemon and levels it up
+// along its species' growth rate. It returns the number of levels gained.
+func (c *Client) AwardExperience(cp *CaughtPokemon, experience int) (int, error) {
+	if experience < 0 {
+		return 0, fmt.Errorf("experience must not be negative, got %d", experience)
+	}
+	rate, err := c.GetGrowthRate(cp.GrowthRate)
+	if err != nil {
+		return 0, err
+	}
+
+	cp.Experience += experience
+	if maxExp := rate.ExperienceAt(MaxLevel); maxExp > 0 && cp.Experience > maxExp {
+		cp.Experience = maxExp
+	}
+
+	before := cp.Level
+	if level := rate.LevelFor(cp.Experience); level > cp.Level {
+		cp.Level = level
+	}
+	gained := cp.Level - before
+	cp.Friendship = min(MaxFriendship, cp.Friendship+gained*friendshipPerLevel)
+	return gained, nil
+}
+
+// HP is the Pokemon's current hit points.
+func (cp *CaughtPokemon) HP() int {
+	return max(0, cp.Stats().HP-cp.Damage)
+}
+
+// SetHP records the Pokemon's hit points after a battle.
+func (cp *CaughtPokemon) SetHP(hp int) {
+	maxHP := cp.Stats().HP
+	cp.Damage = maxHP - max(0, min(hp, maxHP))
+}
+
+func (cp *CaughtPokemon) Fainted() bool {
+	return cp.HP() == 0
+}
+
+// Heal restores up to amount HP, or all of it for fullHP, and returns the
+// HP actually restored.
+func (cp *CaughtPokemon) Heal(amount int) int {
+	if amount == fullHP || amount > cp.Damage {
+		amount = cp.Damage
+	}
+	cp.Damage -= amount
+	return amount
+}
+
+// AddEffort adds EVs to a caught Pokemon, stopping at MaxEV per stat and
+// MaxEVs in total. It returns the EVs actually gained.
+func (cp *CaughtPokemon) AddEffort(evs StatSet) StatSet {
+	var gained StatSet
+	for _, name := range StatNames {
+		room := min(MaxEV-cp.EVs.Get(name), MaxEVs-cp.EVs.Total())
+		add := max(0, min(evs.Get(name), room))
+		cp.EVs.Set(name, cp.EVs.Get(name)+add)
+		gained.Set(name, add)
+	}
+	return gained
+}
+
+// ExperienceYield is the experience earned for defeating a Pokemon, using
+// the generation I-IV formula. Trainer-owned Pokemon give 1.5x.
+func ExperienceYield(baseExperience, level int, wild bool) int {
+	exp := baseExperience * level / 7
+	if !wild {
+		exp = exp * 3 / 2
+	}
+	return max(1, exp)
+}
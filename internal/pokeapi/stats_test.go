@@ -0,0 +1,130 @@
+package pokeapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculateStats(t *testing.T) {
+	// Pikachu's base stats at level 50, with no IVs or EVs.
+	base := StatSet{HP: 35, Attack: 55, Defense: 40, SpecialAttack: 50, SpecialDefense: 50, Speed: 90}
+	stats := CalculateStats(base, StatSet{}, StatSet{}, 50, NatureByName("hardy"))
+
+	assert.Equal(t, StatSet{HP: 95, Attack: 60, Defense: 45, SpecialAttack: 55, SpecialDefense: 55, Speed: 95}, stats)
+}
+
+func TestCalculateStats_IVsEVsAndNature(t *testing.T) {
+	// The level 78 Adamant Garchomp worked through on Bulbapedia.
+	base := StatSet{HP: 108, Attack: 130, Defense: 95, SpecialAttack: 80, SpecialDefense: 85, Speed: 102}
+	ivs := StatSet{HP: 24, Attack: 12, Defense: 30, SpecialAttack: 16, SpecialDefense: 23, Speed: 5}
+	evs := StatSet{HP: 74, Attack: 190, Defense: 91, SpecialAttack: 48, SpecialDefense: 84, Speed: 23}
+	stats := CalculateStats(base, ivs, evs, 78, NatureByName("adamant"))
+
+	assert.Equal(t, StatSet{HP: 289, Attack: 278, Defense: 193, SpecialAttack: 135, SpecialDefense: 171, Speed: 171}, stats)
+}
+
+func TestNatureByName_UnknownIsNeutral(t *testing.T) {
+	nature := NatureByName("grumpy")
+	assert.Equal(t, "hardy", nature.Name)
+	assert.Equal(t, nature.Increased, nature.Decreased)
+}
+
+func TestGrowthRate_LevelFor(t *testing.T) {
+	client, _ := newTestClient(t)
+	rate, err := client.GetGrowthRate("medium")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 125, rate.ExperienceAt(5))
+	assert.Equal(t, 1, rate.LevelFor(0))
+	assert.Equal(t, 4, rate.LevelFor(124))
+	assert.Equal(t, 5, rate.LevelFor(125))
+	assert.Equal(t, MaxLevel, rate.LevelFor(10_000_000))
+}
+
+func TestCatchPokemon_RollsLevelAndStats(t *testing.T) {
+	client, _ := newTestClient(t)
+	client.Rand = fixedRand{roll: 0}
+
+	result, err := client.CatchPokemon("pikachu", CatchOptions{Level: 12})
+	assert.NoError(t, err)
+	assert.True(t, result.Caught)
+
+	caught := result.Entry
+	assert.Equal(t, 12, caught.Level)
+	assert.Equal(t, 12*12*12, caught.Experience)
+	assert.Equal(t, "hardy", caught.Nature)
+	assert.Equal(t, StatSet{}, caught.IVs)
+	assert.Equal(t, 30, caught.Stats().HP)
+}
+
+func TestCatchPokemon_InvalidLevel(t *testing.T) {
+	client, _ := newTestClient(t)
+	_, err := client.CatchPokemon("pikachu", CatchOptions{Level: 101})
+	assert.ErrorContains(t, err, "level must be between 1 and 100")
+}
+
+func TestAwardExperience_LevelsUp(t *testing.T) {
+	client, _ := newTestClient(t)
+	caught := catchForTest(t, client, "pikachu", DefaultCatchLevel)
+	caught.Experience = 125
+
+	before := caught.Stats()
+	levels, err := client.AwardExperience(caught, 91)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, levels)
+	assert.Equal(t, 6, caught.Level)
+	assert.Greater(t, caught.Stats().HP, before.HP)
+
+	levels, err = client.AwardExperience(caught, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, levels)
+
+	_, err = client.AwardExperience(caught, -1)
+	assert.Error(t, err)
+}
+
+func TestExperienceYield(t *testing.T) {
+	assert.Equal(t, 80, ExperienceYield(112, 5, true))
+	assert.Equal(t, 120, ExperienceYield(112, 5, false))
+	assert.Equal(t, 1, ExperienceYield(0, 1, true))
+}
+
+func TestBaseStats_ByName(t *testing.T) {
+	named := func(name string, base, effort int) Stat {
+		return Stat{BaseStat: base, Effort: effort, Stat: NamedAPIResource{Name: name}}
+	}
+	p := Pokemon{Stats: []Stat{
+		named("speed", 90, 2),
+		named("hp", 35, 0),
+		named("accuracy", 100, 0),
+	}}
+	assert.Equal(t, StatSet{HP: 35, Speed: 90}, BaseStats(p))
+	assert.Equal(t, StatSet{Speed: 2}, EffortYield(p))
+}
+
+func TestAddEffort_Caps(t *testing.T) {
+	cp := &CaughtPokemon{EVs: StatSet{Speed: 251, Attack: 252, HP: 4}}
+	gained := cp.AddEffort(StatSet{Speed: 3, Attack: 1, Defense: 2})
+	assert.Equal(t, StatSet{Speed: 1, Defense: 2}, gained)
+	assert.Equal(t, StatSet{HP: 4, Attack: 252, Defense: 2, Speed: 252}, cp.EVs)
+
+	cp.EVs = StatSet{HP: 252, Attack: 252, Defense: 5}
+	gained = cp.AddEffort(StatSet{Speed: 3, SpecialAttack: 3})
+	assert.Equal(t, MaxEVs, cp.EVs.Total())
+	assert.Equal(t, 1, gained.Total())
+}
+
+func TestGetPokemon_DecodesFullData(t *testing.T) {
+	client, _ := newTestClient(t)
+
+	p, err := client.GetPokemon("pikachu")
+	assert.NoError(t, err)
+	assert.Equal(t, 25, p.ID)
+	assert.Equal(t, "pikachu", p.Species.Name)
+	assert.Equal(t, "speed", p.Stats[5].Stat.Name)
+	assert.Equal(t, StatSet{Speed: 2}, EffortYield(p))
+	assert.Equal(t, "static", p.Abilities[0].Ability.Name)
+	assert.True(t, p.Abilities[1].IsHidden)
+	assert.NotNil(t, p.Sprites.FrontDefault)
+}
@@ -0,0 +1,93 @@
+package pokeapi
+
+import (
+	"fmt"
+	"slices"
+)
+
+const (
+	startingMoney = 3000
+	// catchRewardPerExp is the money earned per point of base experience
+	// when a Pokemon is caught.
+	catchRewardPerExp = 2
+)
+
+// ShopItems are the items the shop sells, in display order.
+var ShopItems = []string{
+	"poke-ball", "great-ball", "ultra-ball",
+	"potion", "super-potion", "hyper-potion", "revive",
+	"fire-stone", "water-stone", "thunder-stone", "leaf-stone", "moon-stone",
+}
+
+// Trainer is the player's wallet, item inventory (keyed by PokeAPI item
+// name), caught Pokemon and whereabouts.
+type Trainer struct {
+	Money  int                `json:"money"`
+	Items  map[string]int     `json:"items"`
+	Party  []*CaughtPokemon   `json:"party"`
+	Boxes  [][]*CaughtPokemon `json:"boxes"`
+	NextID int                `json:"next_id"`
+
+	Position Position `json:"position"`
+	// FreeCatch lets the trainer catch any Pokemon, not just those found
+	// where they are.
+	FreeCatch bool `json:"free_catch"`
+	// Dex records every species the trainer has seen or caught, even
+	// after the Pokemon are released or evolved.
+	Dex map[string]DexStatus `json:"dex"`
+}
+
+func NewTrainer() *Trainer {
+	t := &Trainer{
+		Money: startingMoney,
+		Items: map[string]int{"poke-ball": 10},
+		Dex:   make(map[string]DexStatus),
+	}
+	t.ensureBoxes()
+	return t
+}
+
+func (t *Trainer) AddItem(name string, quantity int) {
+	t.Items[name] += quantity
+}
+
+// UseItem removes one of the named item from the inventory.
+func (t *Trainer) UseItem(name string) error {
+	if t.Items[name] <= 0 {
+		return fmt.Errorf("you have no %s left", name)
+	}
+	t.Items[name]--
+	if t.Items[name] == 0 {
+		delete(t.Items, name)
+	}
+	return nil
+}
+
+// BuyItem buys quantity of one of the ShopItems at its PokeAPI cost and
+// returns the total spent.
+func (c *Client) BuyItem(name string, quantity int) (int, error) {
+	if quantity <= 0 {
+		return 0, fmt.Errorf("quantity must be positive, got %d", quantity)
+	}
+	if !slices.Contains(ShopItems, name) {
+		return 0, fmt.Errorf("%s is not for sale", name)
+	}
+
+	item, err := c.GetItem(name)
+	if err != nil {
+		return 0, err
+	}
+	if item.Cost <= 0 {
+		return 0, fmt.Errorf("%s is not for sale", name)
+	}
+
+	// Checked before multiplying so that huge quantities can't overflow.
+	if quantity > c.Trainer.Money/item.Cost {
+		return 0, fmt.Errorf("not enough money: %s costs $%d each, you have $%d", name, item.Cost, c.Trainer.Money)
+	}
+	total := item.Cost * quantity
+
+	c.Trainer.Money -= total
+	c.Trainer.AddItem(name, quantity)
+	return total, nil
+}
@@ -0,0 +1,110 @@
+package pokeapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/danalytis/pokedexcli/internal/pokeapitest"
+	"github.com/danalytis/pokedexcli/internal/pokecache"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(t *testing.T) (*Client, *pokeapitest.Server) {
+	t.Helper()
+	server := pokeapitest.NewServer()
+	t.Cleanup(server.Close)
+
+	cache := pokecache.NewCache(5 * time.Minute)
+	return NewClientWithBaseURL(&cache, server.BaseURL()), server
+}
+
+func TestNewTrainer_StartingInventory(t *testing.T) {
+	trainer := NewTrainer()
+	assert.Equal(t, startingMoney, trainer.Money)
+	assert.Equal(t, 10, trainer.Items["poke-ball"])
+}
+
+func TestTrainer_UseItem(t *testing.T) {
+	trainer := NewTrainer()
+	trainer.Items = map[string]int{"great-ball": 1}
+
+	assert.NoError(t, trainer.UseItem("great-ball"))
+	_, ok := trainer.Items["great-ball"]
+	assert.False(t, ok)
+
+	assert.Error(t, trainer.UseItem("great-ball"))
+}
+
+func TestCatchPokemon_ConsumesBall(t *testing.T) {
+	client, _ := newTestClient(t)
+	client.Rand = fixedRand{roll: 999}
+
+	_, err := client.CatchPokemon("pikachu", CatchOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 9, client.Trainer.Items["poke-ball"])
+	assert.Equal(t, startingMoney, client.Trainer.Money)
+}
+
+func TestCatchPokemon_NoBallsLeft(t *testing.T) {
+	client, server := newTestClient(t)
+
+	_, err := client.CatchPokemon("pikachu", CatchOptions{Ball: "ultra"})
+	assert.ErrorContains(t, err, "no Ultra Balls left")
+	assert.Equal(t, 0, server.TotalRequests())
+}
+
+func TestCatchPokemon_FailedRequestKeepsBall(t *testing.T) {
+	client, server := newTestClient(t)
+	server.FailWith("pokemon-species/pikachu", 500)
+
+	_, err := client.CatchPokemon("pikachu", CatchOptions{})
+	assert.Error(t, err)
+	assert.Equal(t, 10, client.Trainer.Items["poke-ball"])
+}
+
+func TestCatchPokemon_RewardScalesWithExperience(t *testing.T) {
+	client, _ := newTestClient(t)
+	client.Rand = fixedRand{roll: 0}
+
+	result, err := client.CatchPokemon("pikachu", CatchOptions{})
+	assert.NoError(t, err)
+	assert.True(t, result.Caught)
+	assert.Equal(t, 112*catchRewardPerExp, result.Reward)
+	assert.Equal(t, startingMoney+result.Reward, client.Trainer.Money)
+}
+
+func TestBuyItem(t *testing.T) {
+	client, _ := newTestClient(t)
+
+	spent, err := client.BuyItem("great-ball", 3)
+	assert.NoError(t, err)
+	assert.Equal(t, 1800, spent)
+	assert.Equal(t, startingMoney-1800, client.Trainer.Money)
+	assert.Equal(t, 3, client.Trainer.Items["great-ball"])
+}
+
+func TestBuyItem_Errors(t *testing.T) {
+	cases := []struct {
+		name     string
+		item     string
+		quantity int
+		errMsg   string
+	}{
+		{"not enough money", "ultra-ball", 10, "not enough money"},
+		{"quantity overflows the total", "poke-ball", 1 << 62, "not enough money"},
+		{"not for sale", "master-ball", 1, "not for sale"},
+		{"priced but not in the shop", "rare-candy", 1, "not for sale"},
+		{"unknown item", "leftovers", 1, "not for sale"},
+		{"bad quantity", "poke-ball", 0, "quantity must be positive"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			client, _ := newTestClient(t)
+
+			_, err := client.BuyItem(c.item, c.quantity)
+			assert.ErrorContains(t, err, c.errMsg)
+			assert.Equal(t, startingMoney, client.Trainer.Money)
+		})
+	}
+}
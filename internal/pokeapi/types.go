@@ -0,0 +1,43 @@
+package pokeapi
+
+type DamageRelations struct {
+	DoubleDamageTo   []NamedAPIResource `json:"double_damage_to"`
+	HalfDamageTo     []NamedAPIResource `json:"half_damage_to"`
+	NoDamageTo       []NamedAPIResource `json:"no_damage_to"`
+	DoubleDamageFrom []NamedAPIResource `json:"double_damage_from"`
+	HalfDamageFrom   []NamedAPIResource `json:"half_damage_from"`
+	NoDamageFrom     []NamedAPIResource `json:"no_damage_from"`
+}
+
+type TypeResponse struct {
+	Name            string          `json:"name"`
+	DamageRelations DamageRelations `json:"damage_relations"`
+	Pokemon         []TypePokemon   `json:"pokemon"`
+}
+
+// TypePokemon is a Pokemon that has a type, in the given type slot.
+type TypePokemon struct {
+	Slot    int              `json:"slot"`
+	Pokemon NamedAPIResource `json:"pokemon"`
+}
+
+func (c *Client) GetType(name string) (TypeResponse, error) {
+	url := c.PokeapiBaseURL + "type/" + name
+	var typeResp TypeResponse
+
+	err := c.fetchAndCache(url, &typeResp)
+	if err != nil {
+		return TypeResponse{}, err
+	}
+
+	return typeResp, nil
+}
+
+// ListTypes returns every type PokeAPI knows about.
+func (c *Client) ListTypes() ([]NamedAPIResource, error) {
+	list, err := c.GetResourceList(c.PokeapiBaseURL + "type?limit=100")
+	if err != nil {
+		return nil, err
+	}
+	return list.Results, nil
+}
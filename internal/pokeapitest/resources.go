@@ -0,0 +1,249 @@
+package pokeapitest
+
+import (
+	"fmt"
+	"sort"
+)
+
+type PokemonMove struct {
+	Move                NamedResource       `json:"move"`
+	VersionGroupDetails []MoveVersionDetail `json:"version_group_details"`
+}
+
+type MoveVersionDetail struct {
+	LevelLearnedAt  int           `json:"level_learned_at"`
+	MoveLearnMethod NamedResource `json:"move_learn_method"`
+	VersionGroup    NamedResource `json:"version_group"`
+}
+
+type VerboseEffect struct {
+	Effect      string        `json:"effect"`
+	ShortEffect string        `json:"short_effect"`
+	Language    NamedResource `json:"language"`
+}
+
+type Move struct {
+	Name          string          `json:"name"`
+	Power         *int            `json:"power"`
+	Accuracy      *int            `json:"accuracy"`
+	PP            int             `json:"pp"`
+	Priority      int             `json:"priority"`
+	Type          NamedResource   `json:"type"`
+	DamageClass   NamedResource   `json:"damage_class"`
+	EffectChance  *int            `json:"effect_chance"`
+	EffectEntries []VerboseEffect `json:"effect_entries"`
+}
+
+type DamageRelations struct {
+	DoubleDamageTo   []NamedResource `json:"double_damage_to"`
+	HalfDamageTo     []NamedResource `json:"half_damage_to"`
+	NoDamageTo       []NamedResource `json:"no_damage_to"`
+	DoubleDamageFrom []NamedResource `json:"double_damage_from"`
+	HalfDamageFrom   []NamedResource `json:"half_damage_from"`
+	NoDamageFrom     []NamedResource `json:"no_damage_from"`
+}
+
+type TypePokemon struct {
+	Slot    int           `json:"slot"`
+	Pokemon NamedResource `json:"pokemon"`
+}
+
+type PokemonType struct {
+	Name            string          `json:"name"`
+	DamageRelations DamageRelations `json:"damage_relations"`
+	Pokemon         []TypePokemon   `json:"pokemon"`
+}
+
+type GrowthRateLevel struct {
+	Level      int `json:"level"`
+	Experience int `json:"experience"`
+}
+
+type GrowthRate struct {
+	Name   string            `json:"name"`
+	Levels []GrowthRateLevel `json:"levels"`
+}
+
+type EvolutionDetail struct {
+	Trigger      NamedResource  `json:"trigger"`
+	MinLevel     *int           `json:"min_level"`
+	MinHappiness *int           `json:"min_happiness"`
+	Item         *NamedResource `json:"item"`
+	HeldItem     *NamedResource `json:"held_item"`
+	TimeOfDay    string         `json:"time_of_day"`
+}
+
+type ChainLink struct {
+	Species          NamedResource     `json:"species"`
+	IsBaby           bool              `json:"is_baby"`
+	EvolutionDetails []EvolutionDetail `json:"evolution_details"`
+	EvolvesTo        []ChainLink       `json:"evolves_to"`
+}
+
+type EvolutionChain struct {
+	ID    int       `json:"id"`
+	Chain ChainLink `json:"chain"`
+}
+
+type Location struct {
+	Name   string          `json:"name"`
+	Region *NamedResource  `json:"region"`
+	Areas  []NamedResource `json:"areas"`
+}
+
+type Region struct {
+	Name           string          `json:"name"`
+	Locations      []NamedResource `json:"locations"`
+	Pokedexes      []NamedResource `json:"pokedexes"`
+	MainGeneration *NamedResource  `json:"main_generation"`
+}
+
+type PokemonEntry struct {
+	EntryNumber    int           `json:"entry_number"`
+	PokemonSpecies NamedResource `json:"pokemon_species"`
+}
+
+type Pokedex struct {
+	Name           string         `json:"name"`
+	IsMainSeries   bool           `json:"is_main_series"`
+	Region         *NamedResource `json:"region"`
+	PokemonEntries []PokemonEntry `json:"pokemon_entries"`
+}
+
+type Generation struct {
+	Name           string          `json:"name"`
+	MainRegion     NamedResource   `json:"main_region"`
+	PokemonSpecies []NamedResource `json:"pokemon_species"`
+}
+
+func (s *Server) AddMove(move Move) {
+	s.AddResource("move/"+move.Name, move)
+}
+
+type AbilityPokemon struct {
+	IsHidden bool          `json:"is_hidden"`
+	Slot     int           `json:"slot"`
+	Pokemon  NamedResource `json:"pokemon"`
+}
+
+type Ability struct {
+	Name          string           `json:"name"`
+	EffectEntries []VerboseEffect  `json:"effect_entries"`
+	Pokemon       []AbilityPokemon `json:"pokemon"`
+}
+
+func (s *Server) AddAbility(ability Ability) {
+	s.AddResource("ability/"+ability.Name, ability)
+}
+
+type BerryFlavor struct {
+	Potency int           `json:"potency"`
+	Flavor  NamedResource `json:"flavor"`
+}
+
+type Berry struct {
+	Name             string        `json:"name"`
+	GrowthTime       int           `json:"growth_time"`
+	MaxHarvest       int           `json:"max_harvest"`
+	NaturalGiftPower int           `json:"natural_gift_power"`
+	NaturalGiftType  NamedResource `json:"natural_gift_type"`
+	Firmness         NamedResource `json:"firmness"`
+	Flavors          []BerryFlavor `json:"flavors"`
+	Item             NamedResource `json:"item"`
+}
+
+func (s *Server) AddBerry(berry Berry) {
+	s.AddResource("berry/"+berry.Name, berry)
+}
+
+func (s *Server) AddType(t PokemonType) {
+	s.AddResource("type/"+t.Name, t)
+}
+
+func (s *Server) AddGrowthRate(rate GrowthRate) {
+	s.AddResource("growth-rate/"+rate.Name, rate)
+}
+
+func (s *Server) AddEvolutionChain(chain EvolutionChain) {
+	s.AddResource(fmt.Sprintf("evolution-chain/%d", chain.ID), chain)
+}
+
+// EvolutionChainURL is the URL a species uses to link to the chain with
+// the given ID.
+func (s *Server) EvolutionChainURL(id int) string {
+	return fmt.Sprintf("%sevolution-chain/%d/", s.BaseURL(), id)
+}
+
+// SpeciesNames returns the names of every species in the chain, root
+// first.
+func (l ChainLink) SpeciesNames() []string {
+	names := []string{l.Species.Name}
+	for _, next := range l.EvolvesTo {
+		names = append(names, next.SpeciesNames()...)
+	}
+	return names
+}
+
+// AddLocation serves a location in region made up of the named areas.
+func (s *Server) AddLocation(name, region string, areas ...string) {
+	location := Location{Name: name, Areas: []NamedResource{}}
+	if region != "" {
+		location.Region = &NamedResource{Name: region, URL: s.BaseURL() + "region/" + region + "/"}
+	}
+
+	s.mu.Lock()
+	for _, area := range areas {
+		s.areaLocations[area] = name
+		location.Areas = append(location.Areas, NamedResource{Name: area, URL: s.BaseURL() + "location-area/" + area + "/"})
+	}
+	s.mu.Unlock()
+
+	s.AddResource("location/"+name, location)
+}
+
+func (s *Server) AddRegion(region Region) {
+	s.AddResource("region/"+region.Name, region)
+}
+
+// AddPokedex serves a pokedex listing species in order, numbered from 1.
+func (s *Server) AddPokedex(name, region string, species ...string) {
+	numbers := make(map[string]int)
+	for i, sp := range species {
+		numbers[sp] = i + 1
+	}
+	s.AddNumberedPokedex(name, region, numbers)
+}
+
+// AddNumberedPokedex serves a pokedex with the given entry numbers. The
+// national dex has no region.
+func (s *Server) AddNumberedPokedex(name, region string, numbers map[string]int) {
+	dex := Pokedex{Name: name, IsMainSeries: true, PokemonEntries: []PokemonEntry{}}
+	if region != "" {
+		dex.Region = s.Ref("region", region)
+	}
+	for sp, number := range numbers {
+		dex.PokemonEntries = append(dex.PokemonEntries, PokemonEntry{EntryNumber: number, PokemonSpecies: *s.Ref("pokemon-species", sp)})
+	}
+	sort.Slice(dex.PokemonEntries, func(i, j int) bool {
+		return dex.PokemonEntries[i].EntryNumber < dex.PokemonEntries[j].EntryNumber
+	})
+	s.AddResource("pokedex/"+name, dex)
+}
+
+func (s *Server) AddGeneration(generation Generation) {
+	s.AddResource("generation/"+generation.Name, generation)
+}
+
+// Ref links to the named resource of a kind, e.g. Ref("region", "kanto").
+func (s *Server) Ref(kind, name string) *NamedResource {
+	return &NamedResource{Name: name, URL: s.BaseURL() + kind + "/" + name + "/"}
+}
+
+// Refs links to several resources of one kind.
+func (s *Server) Refs(kind string, names ...string) []NamedResource {
+	refs := []NamedResource{}
+	for _, name := range names {
+		refs = append(refs, *s.Ref(kind, name))
+	}
+	return refs
+}
@@ -0,0 +1,794 @@
+package pokeapitest
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+var statNames = []string{"hp", "attack", "defense", "special-attack", "special-defense", "speed"}
+
+// SeedPokemon is the Pokemon served by NewServer, with base data taken from
+// PokeAPI.
+var SeedPokemon = []Pokemon{
+	newPokemon("bulbasaur", 64, 7, 69, []int{45, 49, 49, 65, 65, 45}, "grass", "poison").
+		withMoves("tackle", "vine-whip").
+		withEffort("special-attack", 1).
+		withAbilities("chlorophyll", "overgrow"),
+	newPokemon("charmander", 62, 6, 85, []int{39, 52, 43, 60, 50, 65}, "fire").
+		withMoves("scratch", "ember").
+		withEffort("speed", 1).
+		withAbilities("solar-power", "blaze"),
+	newPokemon("squirtle", 63, 5, 90, []int{44, 48, 65, 50, 64, 43}, "water").
+		withMoves("tackle", "water-gun").
+		withEffort("defense", 1).
+		withAbilities("rain-dish", "torrent"),
+	newPokemon("pikachu", 112, 4, 60, []int{35, 55, 40, 50, 50, 90}, "electric").
+		withMoves("thunder-wave", "quick-attack", "thunder-shock", "thunderbolt").
+		learns(DefaultVersionGroup, "level-up", 8, "thunder-wave").
+		learns(DefaultVersionGroup, "level-up", 13, "quick-attack").
+		learns(DefaultVersionGroup, "machine", 0, "thunderbolt").
+		learns("red-blue", "level-up", 1, "thunder-shock").
+		withEffort("speed", 2).
+		withAbilities("lightning-rod", "static"),
+	newPokemon("gible", 60, 7, 205, []int{58, 70, 45, 40, 45, 42}, "dragon", "ground").
+		withMoves("tackle", "sand-attack", "dragon-claw", "earthquake").
+		withEffort("attack", 1).
+		withAbilities("rough-skin", "sand-veil"),
+	newPokemon("burmy", 45, 2, 34, []int{40, 29, 45, 29, 45, 36}, "bug").
+		withMoves("tackle").
+		withEffort("special-defense", 1),
+	newPokemon("wormadam-plant", 148, 5, 65, []int{60, 59, 85, 79, 105, 36}, "bug", "grass").
+		ofSpecies("wormadam").
+		withMoves("tackle").
+		withEffort("special-defense", 2),
+	newPokemon("tentacool", 67, 9, 455, []int{40, 40, 35, 50, 100, 70}, "water", "poison").
+		withMoves("poison-sting", "water-gun").
+		withEffort("special-defense", 1).
+		withAbilities("rain-dish", "clear-body", "liquid-ooze").
+		withHeldItem("poison-barb", 5, "diamond", "pearl"),
+	newPokemon("shellos", 65, 3, 63, []int{76, 48, 48, 57, 62, 34}, "water").
+		withMoves("water-gun", "earthquake").
+		withEffort("hp", 1).
+		withAbilities("sand-force", "sticky-hold", "storm-drain"),
+	newPokemon("mewtwo", 340, 20, 1220, []int{106, 110, 90, 154, 90, 130}, "psychic").
+		withMoves("psychic", "earthquake").
+		withEffort("special-attack", 3).
+		withAbilities("unnerve", "pressure"),
+	newPokemon("ivysaur", 142, 10, 130, []int{60, 62, 63, 80, 80, 60}, "grass", "poison").
+		withMoves("tackle", "vine-whip").
+		withEffort("special-attack", 1).withEffort("special-defense", 1).
+		withAbilities("chlorophyll", "overgrow"),
+	newPokemon("venusaur", 263, 20, 1000, []int{80, 82, 83, 100, 100, 80}, "grass", "poison").
+		withMoves("tackle", "vine-whip").
+		withEffort("special-attack", 2).withEffort("special-defense", 1).
+		withAbilities("chlorophyll", "overgrow"),
+	newPokemon("pichu", 41, 3, 20, []int{20, 40, 15, 35, 35, 60}, "electric").
+		withMoves("thunder-shock").
+		withEffort("speed", 1).
+		withAbilities("lightning-rod", "static"),
+	newPokemon("raichu", 243, 8, 300, []int{60, 90, 55, 90, 80, 110}, "electric").
+		withMoves("quick-attack", "thunderbolt").
+		withEffort("speed", 3).
+		withAbilities("lightning-rod", "static"),
+	newPokemon("eevee", 65, 3, 65, []int{55, 55, 50, 45, 65, 55}, "normal").
+		withMoves("tackle", "quick-attack").
+		withEffort("special-defense", 1).
+		withAbilities("anticipation", "run-away", "adaptability"),
+	newPokemon("vaporeon", 184, 10, 290, []int{130, 65, 60, 110, 95, 65}, "water").
+		withMoves("tackle", "water-gun").
+		withEffort("hp", 2).
+		withAbilities("hydration", "water-absorb"),
+	newPokemon("jolteon", 184, 8, 245, []int{65, 65, 60, 110, 95, 130}, "electric").
+		withMoves("tackle", "thunder-shock").
+		withEffort("speed", 2).
+		withAbilities("quick-feet", "volt-absorb"),
+	newPokemon("espeon", 184, 9, 265, []int{65, 65, 60, 130, 95, 110}, "psychic").
+		withMoves("tackle", "psychic").
+		withEffort("special-attack", 2).
+		withAbilities("magic-bounce", "synchronize"),
+	newPokemon("umbreon", 184, 10, 270, []int{95, 65, 110, 60, 130, 65}, "dark").
+		withMoves("tackle", "bite").
+		withEffort("special-defense", 2).
+		withAbilities("inner-focus", "synchronize"),
+	newPokemon("machop", 61, 8, 195, []int{70, 80, 50, 35, 35, 35}, "fighting").
+		withMoves("karate-chop").
+		withEffort("attack", 1).
+		withAbilities("steadfast", "guts", "no-guard"),
+	newPokemon("machoke", 142, 15, 705, []int{80, 100, 70, 50, 60, 45}, "fighting").
+		withMoves("karate-chop").
+		withEffort("attack", 2).
+		withAbilities("steadfast", "guts", "no-guard"),
+	newPokemon("machamp", 253, 16, 1300, []int{90, 130, 80, 65, 85, 55}, "fighting").
+		withMoves("karate-chop").
+		withEffort("attack", 3).
+		withAbilities("steadfast", "guts", "no-guard"),
+}
+
+// SeedSpecies is the species data served by NewServer for each of
+// SeedPokemon.
+var SeedSpecies = []PokemonSpecies{
+	newSpecies("bulbasaur", 45, "medium-slow"),
+	newSpecies("charmander", 45, "medium-slow"),
+	newSpecies("squirtle", 45, "medium-slow"),
+	newSpecies("pikachu", 190, "medium").
+		withName("en", "Pikachu", "Mouse Pokémon").
+		withName("ja", "ピカチュウ", "ねずみポケモン").
+		withName("de", "Pikachu", "Maus").
+		withFlavorText("red", "en", "When several of\nthese POKéMON\ngather, their\felectricity could\nbuild and cause\nlightning storms.").
+		withFlavorText("diamond", "en", "It lives in forests with others. It\nstores electricity in the pouches\non its cheeks.").
+		withFlavorText("diamond", "ja", "なかまと　もりで　くらす。\nほっぺの　ふくろに\n電気を　ためている。"),
+	newSpecies("gible", 45, "slow").
+		withName("en", "Gible", "Land Shark Pokémon").
+		withName("ja", "フカマル", "りくザメポケモン").
+		withName("de", "Kaumalat", "Landhai").
+		withName("zh-Hant", "圓陸鯊", "陸鯊寶可夢").
+		withFlavorText("sword", "zh-Hant", "棲息在洞窟牆壁上的橫洞裡。\n一旦有獵物靠近，\n就會跳出來撲上去。").
+		withFlavorText("diamond", "en", "It nests in small, horizontal holes\nin cave walls. It pounces to catch\nprey that stray too close."),
+	newSpecies("tentacool", 190, "slow"),
+	newSpecies("shellos", 190, "medium"),
+	newSpecies("mewtwo", 3, "slow"),
+	newSpecies("ivysaur", 45, "medium-slow"),
+	newSpecies("venusaur", 45, "medium-slow"),
+	newSpecies("pichu", 190, "medium"),
+	newSpecies("raichu", 75, "medium"),
+	newSpecies("eevee", 45, "medium"),
+	newSpecies("vaporeon", 45, "medium"),
+	newSpecies("jolteon", 45, "medium"),
+	newSpecies("espeon", 45, "medium"),
+	newSpecies("umbreon", 45, "medium"),
+	newSpecies("machop", 180, "medium-slow"),
+	newSpecies("machoke", 90, "medium-slow"),
+	newSpecies("machamp", 45, "medium-slow"),
+	newSpecies("burmy", 120, "medium"),
+	newSpecies("wormadam", 45, "medium").withDefaultForm("wormadam-plant"),
+}
+
+// SeedEvolutionChains covers a plain level-up line, a baby Pokemon that
+// evolves with friendship, evolution stones, Eevee's branches, a trade
+// evolution and one into a species whose Pokemon are all named forms.
+var SeedEvolutionChains = []EvolutionChain{
+	{ID: 1, Chain: evolves("bulbasaur", nil,
+		evolves("ivysaur", []EvolutionDetail{levelUp(16)},
+			evolves("venusaur", []EvolutionDetail{levelUp(32)})))},
+	{ID: 10, Chain: baby(evolves("pichu", nil,
+		evolves("pikachu", []EvolutionDetail{friendship(220, "")},
+			evolves("raichu", []EvolutionDetail{useItem("thunder-stone")}))))},
+	{ID: 27, Chain: evolves("machop", nil,
+		evolves("machoke", []EvolutionDetail{levelUp(28)},
+			evolves("machamp", []EvolutionDetail{{Trigger: NamedResource{Name: "trade"}}})))},
+	{ID: 63, Chain: evolves("mewtwo", nil)},
+	{ID: 67, Chain: evolves("eevee", nil,
+		evolves("vaporeon", []EvolutionDetail{useItem("water-stone")}),
+		evolves("jolteon", []EvolutionDetail{useItem("thunder-stone")}),
+		evolves("espeon", []EvolutionDetail{friendship(160, "day")}),
+		evolves("umbreon", []EvolutionDetail{friendship(160, "night")}))},
+	{ID: 213, Chain: evolves("burmy", nil,
+		evolves("wormadam", []EvolutionDetail{levelUp(20)}))},
+}
+
+// SeedGrowthRates gives the total experience needed for each level on the
+// growth rates used by SeedSpecies.
+var SeedGrowthRates = map[string]func(level int) int{
+	"slow":   func(n int) int { return 5 * n * n * n / 4 },
+	"medium": func(n int) int { return n * n * n },
+	"medium-slow": func(n int) int {
+		return max(0, 6*n*n*n/5-15*n*n+100*n-140)
+	},
+}
+
+// SeedItems is the item data served by NewServer.
+var SeedItems = []Item{
+	newItem("poke-ball", 200, "standard-balls", "Used to catch a wild Pokémon."),
+	newItem("great-ball", 600, "standard-balls", "Used to catch a wild Pokémon. 1.5× the catch rate of a Poké Ball."),
+	newItem("ultra-ball", 800, "standard-balls", "Used to catch a wild Pokémon. 2× the catch rate of a Poké Ball."),
+	newItem("master-ball", 0, "special-balls", "Catches a wild Pokémon every time."),
+	newItem("fire-stone", 3000, "evolution", "Evolves a Pokémon."),
+	newItem("water-stone", 3000, "evolution", "Evolves a Pokémon."),
+	newItem("thunder-stone", 3000, "evolution", "Evolves a Pokémon."),
+	newItem("leaf-stone", 3000, "evolution", "Evolves a Pokémon."),
+	newItem("moon-stone", 3000, "evolution", "Evolves a Pokémon."),
+	newItem("potion", 300, "healing", "Restores 20 HP."),
+	newItem("super-potion", 700, "healing", "Restores 50 HP."),
+	newItem("hyper-potion", 1200, "healing", "Restores 200 HP."),
+	newItem("max-potion", 2500, "healing", "Restores all HP."),
+	newItem("revive", 1500, "revival", "Revives a fainted Pokémon to half its max HP."),
+	newItem("rare-candy", 4800, "vitamins", "Raises a Pokémon's level by one."),
+	newItem("oran-berry", 20, "medicine", "Held: Consumed when HP falls below 50% to restore 10 HP.").
+		withName("de", "Sinelbeere"),
+	newItem("sitrus-berry", 80, "medicine", "Held: Consumed when HP falls below 50% to restore 30 HP."),
+	newItem("poison-barb", 1000, "type-enhancement", "Held: Poison-type moves from holder do 20% more damage."),
+}
+
+// SeedBerries is the berry data served by NewServer.
+var SeedBerries = []Berry{
+	newBerry("oran", 4, 60, "poison", "super-hard", map[string]int{"spicy": 10, "dry": 10, "bitter": 10, "sour": 10}),
+	newBerry("sitrus", 8, 60, "psychic", "very-hard", map[string]int{"dry": 10, "sweet": 10, "bitter": 10, "sour": 10}),
+}
+
+// SeedMoves is the move data served by NewServer, covering every move in
+// SeedPokemon.
+var SeedMoves = []Move{
+	newMove("tackle", 40, 100, 35, 0, "normal", "physical").
+		withEffect("Inflicts regular damage with no additional effect.", 0),
+	newMove("scratch", 40, 100, 35, 0, "normal", "physical"),
+	newMove("quick-attack", 40, 100, 30, 1, "normal", "physical"),
+	newMove("struggle", 50, 0, 1, 0, "normal", "physical"),
+	newMove("thunder-shock", 40, 100, 30, 0, "electric", "special"),
+	newMove("thunderbolt", 90, 100, 15, 0, "electric", "special").
+		withEffect("Has a $effect_chance% chance to paralyze the target.", 10),
+	newMove("thunder-wave", 0, 90, 20, 0, "electric", "status").
+		withEffect("Paralyzes the target.", 0),
+	newMove("ember", 40, 100, 25, 0, "fire", "special"),
+	newMove("water-gun", 40, 100, 25, 0, "water", "special"),
+	newMove("vine-whip", 45, 100, 25, 0, "grass", "physical"),
+	newMove("dragon-claw", 80, 100, 15, 0, "dragon", "physical"),
+	newMove("earthquake", 100, 100, 10, 0, "ground", "physical"),
+	newMove("sand-attack", 0, 100, 15, 0, "ground", "status"),
+	newMove("poison-sting", 15, 100, 35, 0, "poison", "physical"),
+	newMove("psychic", 90, 100, 10, 0, "psychic", "special"),
+	newMove("bite", 60, 100, 25, 0, "dark", "physical"),
+	newMove("karate-chop", 50, 100, 25, 0, "fighting", "physical"),
+}
+
+// TypeChart is the generation VI+ type chart: for each attacking type, the
+// defending types it deals double, half and no damage to.
+var TypeChart = map[string][3][]string{
+	"normal":   {nil, {"rock", "steel"}, {"ghost"}},
+	"fire":     {{"grass", "ice", "bug", "steel"}, {"fire", "water", "rock", "dragon"}, nil},
+	"water":    {{"fire", "ground", "rock"}, {"water", "grass", "dragon"}, nil},
+	"electric": {{"water", "flying"}, {"electric", "grass", "dragon"}, {"ground"}},
+	"grass":    {{"water", "ground", "rock"}, {"fire", "grass", "poison", "flying", "bug", "dragon", "steel"}, nil},
+	"ice":      {{"grass", "ground", "flying", "dragon"}, {"fire", "water", "ice", "steel"}, nil},
+	"fighting": {{"normal", "ice", "rock", "dark", "steel"}, {"poison", "flying", "psychic", "bug", "fairy"}, {"ghost"}},
+	"poison":   {{"grass", "fairy"}, {"poison", "ground", "rock", "ghost"}, {"steel"}},
+	"ground":   {{"fire", "electric", "poison", "rock", "steel"}, {"grass", "bug"}, {"flying"}},
+	"flying":   {{"grass", "fighting", "bug"}, {"electric", "rock", "steel"}, nil},
+	"psychic":  {{"fighting", "poison"}, {"psychic", "steel"}, {"dark"}},
+	"bug":      {{"grass", "psychic", "dark"}, {"fire", "fighting", "poison", "flying", "ghost", "steel", "fairy"}, nil},
+	"rock":     {{"fire", "ice", "flying", "bug"}, {"fighting", "ground", "steel"}, nil},
+	"ghost":    {{"psychic", "ghost"}, {"dark"}, {"normal"}},
+	"dragon":   {{"dragon"}, {"steel"}, {"fairy"}},
+	"dark":     {{"psychic", "ghost"}, {"fighting", "dark", "fairy"}, nil},
+	"steel":    {{"ice", "rock", "fairy"}, {"fire", "water", "electric", "steel"}, nil},
+	"fairy":    {{"fighting", "dragon", "dark"}, {"fire", "poison", "steel"}, nil},
+}
+
+// SeedLocationAreas maps each location area served by NewServer to the
+// Pokemon that can be encountered there. There are more areas than fit on
+// one listing page so that pagination can be exercised.
+var SeedLocationAreas = map[string][]string{
+	"canalave-city-area":                       {"tentacool", "shellos"},
+	"eterna-city-area":                         {},
+	"pastoria-city-area":                       {"tentacool"},
+	"sunyshore-city-area":                      {"tentacool", "shellos"},
+	"sinnoh-pokemon-league-area":               {},
+	"oreburgh-mine-1f":                         {},
+	"oreburgh-mine-b1f":                        {},
+	"valley-windworks-area":                    {"shellos", "pikachu"},
+	"eterna-forest-area":                       {"bulbasaur"},
+	"fuego-ironworks-area":                     {"charmander"},
+	"mt-coronet-1f-route-207":                  {"gible"},
+	"mt-coronet-1f-route-211":                  {},
+	"great-marsh-area-1":                       {"squirtle"},
+	"great-marsh-area-2":                       {},
+	"solaceon-ruins-2f":                        {},
+	"victory-road-1f":                          {},
+	"lake-verity-before-galactic-intervention": {},
+	"lake-acuity-area":                         {},
+	"lake-valor-area":                          {},
+	"wayward-cave-1f":                          {"gible"},
+	"iron-island-area":                         {"tentacool"},
+	"old-chateau-entrance":                     {},
+	"cerulean-cave-b1f":                        {"mewtwo"},
+	"pallet-town-area":                         {"pikachu"},
+}
+
+// SeedLocations groups SeedLocationAreas into their parent locations.
+var SeedLocations = map[string][]string{
+	"canalave-city":         {"canalave-city-area"},
+	"eterna-city":           {"eterna-city-area"},
+	"pastoria-city":         {"pastoria-city-area"},
+	"sunyshore-city":        {"sunyshore-city-area"},
+	"sinnoh-pokemon-league": {"sinnoh-pokemon-league-area"},
+	"oreburgh-mine":         {"oreburgh-mine-1f", "oreburgh-mine-b1f"},
+	"valley-windworks":      {"valley-windworks-area"},
+	"eterna-forest":         {"eterna-forest-area"},
+	"fuego-ironworks":       {"fuego-ironworks-area"},
+	"mt-coronet":            {"mt-coronet-1f-route-207", "mt-coronet-1f-route-211"},
+	"great-marsh":           {"great-marsh-area-1", "great-marsh-area-2"},
+	"solaceon-ruins":        {"solaceon-ruins-2f"},
+	"sinnoh-victory-road":   {"victory-road-1f"},
+	"lake-verity":           {"lake-verity-before-galactic-intervention"},
+	"lake-acuity":           {"lake-acuity-area"},
+	"lake-valor":            {"lake-valor-area"},
+	"wayward-cave":          {"wayward-cave-1f"},
+	"iron-island":           {"iron-island-area"},
+	"old-chateau":           {"old-chateau-entrance"},
+	"cerulean-cave":         {"cerulean-cave-b1f"},
+	"pallet-town":           {"pallet-town-area"},
+}
+
+// SeedRegions groups SeedLocations into regions.
+var SeedRegions = map[string][]string{
+	"kanto": {"pallet-town", "cerulean-cave"},
+	"sinnoh": {
+		"canalave-city", "eterna-city", "pastoria-city", "sunyshore-city",
+		"sinnoh-pokemon-league", "oreburgh-mine", "valley-windworks",
+		"eterna-forest", "fuego-ironworks", "mt-coronet", "great-marsh",
+		"solaceon-ruins", "sinnoh-victory-road", "lake-verity", "lake-acuity",
+		"lake-valor", "wayward-cave", "iron-island", "old-chateau",
+	},
+}
+
+// SeedAbilityEffects is the English effect text of some seeded abilities;
+// the rest are served without any.
+var SeedAbilityEffects = map[string]string{
+	"static":        "Has a 30% chance of paralyzing attacking Pokemon on contact.",
+	"lightning-rod": "Redirects single-target electric moves to this Pokemon.",
+	"rough-skin":    "Damages attacking Pokemon for 1/8 their max HP on contact.",
+	"overgrow":      "Strengthens grass moves to 1.5x their power when below 1/3 max HP.",
+	"synchronize":   "Copies burns, paralysis, and poison received onto the Pokemon that inflicted them.",
+}
+
+// SeedNationalDex numbers every seeded species in the national dex.
+var SeedNationalDex = map[string]int{
+	"bulbasaur": 1, "ivysaur": 2, "venusaur": 3, "charmander": 4,
+	"squirtle": 7, "pikachu": 25, "raichu": 26, "machop": 66,
+	"machoke": 67, "machamp": 68, "tentacool": 72, "eevee": 133,
+	"vaporeon": 134, "jolteon": 135, "mewtwo": 150, "pichu": 172,
+	"espeon": 196, "umbreon": 197, "burmy": 412, "wormadam": 413,
+	"shellos": 422, "gible": 443,
+}
+
+// SeedRegionalDexes lists, for each regional pokedex, its region and then
+// its species in dex order.
+var SeedRegionalDexes = map[string][]string{
+	"kanto": {"kanto",
+		"bulbasaur", "ivysaur", "venusaur", "charmander", "squirtle",
+		"pikachu", "raichu", "machop", "machoke", "machamp", "tentacool",
+		"eevee", "vaporeon", "jolteon", "mewtwo"},
+	"original-sinnoh": {"sinnoh",
+		"machop", "machoke", "machamp", "pichu", "pikachu", "raichu",
+		"shellos", "eevee", "vaporeon", "jolteon", "espeon", "umbreon",
+		"gible"},
+}
+
+// SeedGenerations lists, for each generation, its main region and then
+// the species it introduced.
+var SeedGenerations = map[string][]string{
+	"generation-i": {"kanto",
+		"bulbasaur", "ivysaur", "venusaur", "charmander", "squirtle",
+		"pikachu", "raichu", "machop", "machoke", "machamp", "tentacool",
+		"eevee", "vaporeon", "jolteon", "mewtwo"},
+	"generation-ii": {"johto", "pichu", "espeon", "umbreon"},
+	"generation-iv": {"sinnoh", "shellos", "gible"},
+}
+
+// SeedEncounters replaces the default walking encounters of some of
+// SeedLocationAreas with detailed ones spanning several methods, versions
+// and level ranges.
+var SeedEncounters = map[string][]PokemonEncounter{
+	"canalave-city-area": {
+		withVersions(
+			NewPokemonEncounter("tentacool", "diamond", slot("surf", 60, 20, 30), slot("good-rod", 40, 15, 25)),
+			NewPokemonEncounter("tentacool", "pearl", slot("surf", 60, 20, 30))),
+		withVersions(
+			NewPokemonEncounter("shellos", "diamond", slot("surf", 40, 20, 30)),
+			NewPokemonEncounter("shellos", "pearl", slot("surf", 40, 20, 30))),
+	},
+	"valley-windworks-area": {
+		NewPokemonEncounter("shellos", "diamond", slot("walk", 40, 14, 16)),
+		NewPokemonEncounter("pikachu", "diamond", slot("walk", 10, 13, 15)),
+	},
+}
+
+func seed(s *Server) {
+	for _, p := range SeedPokemon {
+		s.AddPokemon(p)
+	}
+	chains := make(map[string]int)
+	for _, chain := range SeedEvolutionChains {
+		s.AddEvolutionChain(chain)
+		for _, name := range chain.Chain.SpeciesNames() {
+			chains[name] = chain.ID
+		}
+	}
+	for _, species := range SeedSpecies {
+		if id, ok := chains[species.Name]; ok {
+			species.EvolutionChain.URL = s.EvolutionChainURL(id)
+		}
+		s.AddSpecies(species)
+	}
+	for name, experience := range SeedGrowthRates {
+		rate := GrowthRate{Name: name}
+		for level := 1; level <= 100; level++ {
+			rate.Levels = append(rate.Levels, GrowthRateLevel{Level: level, Experience: experience(level)})
+		}
+		s.AddGrowthRate(rate)
+	}
+	for _, item := range SeedItems {
+		s.AddItem(item)
+	}
+	for _, berry := range SeedBerries {
+		s.AddBerry(berry)
+	}
+	for _, move := range SeedMoves {
+		s.AddMove(move)
+	}
+	for _, t := range seedTypes() {
+		s.AddType(t)
+	}
+	for _, a := range seedAbilities() {
+		s.AddAbility(a)
+	}
+	s.AddNumberedPokedex("national", "", SeedNationalDex)
+	for dex, entries := range SeedRegionalDexes {
+		s.AddPokedex(dex, entries[0], entries[1:]...)
+	}
+	for generation, entries := range SeedGenerations {
+		s.AddGeneration(Generation{
+			Name:           generation,
+			MainRegion:     *s.Ref("region", entries[0]),
+			PokemonSpecies: s.Refs("pokemon-species", entries[1:]...),
+		})
+	}
+
+	for region, locations := range SeedRegions {
+		r := Region{Name: region, Locations: s.Refs("location", locations...), Pokedexes: []NamedResource{}}
+		for dex, entries := range SeedRegionalDexes {
+			if entries[0] == region {
+				r.Pokedexes = append(r.Pokedexes, *s.Ref("pokedex", dex))
+			}
+		}
+		for generation, entries := range SeedGenerations {
+			if entries[0] == region {
+				r.MainGeneration = s.Ref("generation", generation)
+			}
+		}
+		s.AddRegion(r)
+		for _, location := range locations {
+			s.AddLocation(location, region, SeedLocations[location]...)
+		}
+	}
+	for area, pokemon := range SeedLocationAreas {
+		if encounters, ok := SeedEncounters[area]; ok {
+			s.AddLocationAreaEncounters(area, encounters...)
+			continue
+		}
+		s.AddLocationArea(area, pokemon...)
+	}
+}
+
+func slot(method string, chance, minLevel, maxLevel int) Encounter {
+	return Encounter{MinLevel: minLevel, MaxLevel: maxLevel, Chance: chance, Method: NamedResource{Name: method}}
+}
+
+// withVersions merges one Pokemon's encounters from several versions.
+func withVersions(first PokemonEncounter, rest ...PokemonEncounter) PokemonEncounter {
+	for _, e := range rest {
+		first.VersionDetails = append(first.VersionDetails, e.VersionDetails...)
+	}
+	return first
+}
+
+// newSpecies builds a species whose English name is its PokeAPI name
+// capitalized.
+func newSpecies(name string, captureRate int, growthRate string) PokemonSpecies {
+	return PokemonSpecies{
+		Name:              name,
+		CaptureRate:       captureRate,
+		BaseHappiness:     50,
+		GrowthRate:        NamedResource{Name: growthRate},
+		Varieties:         []PokemonSpeciesVariety{{IsDefault: true, Pokemon: NamedResource{Name: name}}},
+		Names:             []Name{{Name: strings.ToUpper(name[:1]) + name[1:], Language: NamedResource{Name: "en"}}},
+		Genera:            []Genus{},
+		FlavorTextEntries: []FlavorText{},
+	}
+}
+
+// withName adds the species' name and genus in a language.
+func (s PokemonSpecies) withName(language, name, genus string) PokemonSpecies {
+	lang := NamedResource{Name: language}
+	s.Names = slices.DeleteFunc(s.Names, func(n Name) bool { return n.Language.Name == language })
+	s.Names = append(s.Names, Name{Name: name, Language: lang})
+	s.Genera = append(s.Genera, Genus{Genus: genus, Language: lang})
+	return s
+}
+
+// withDefaultForm makes the named Pokemon the species' default form, for
+// species such as wormadam that have no Pokemon of their own name.
+func (s PokemonSpecies) withDefaultForm(pokemon string) PokemonSpecies {
+	s.Varieties = []PokemonSpeciesVariety{{IsDefault: true, Pokemon: NamedResource{Name: pokemon}}}
+	return s
+}
+
+// withFlavorText adds a Pokedex entry from a game version.
+func (s PokemonSpecies) withFlavorText(version, language, text string) PokemonSpecies {
+	s.FlavorTextEntries = append(s.FlavorTextEntries, FlavorText{
+		FlavorText: text,
+		Language:   NamedResource{Name: language},
+		Version:    NamedResource{Name: version},
+	})
+	return s
+}
+
+func evolves(species string, details []EvolutionDetail, next ...ChainLink) ChainLink {
+	if details == nil {
+		details = []EvolutionDetail{}
+	}
+	if next == nil {
+		next = []ChainLink{}
+	}
+	return ChainLink{
+		Species:          NamedResource{Name: species},
+		EvolutionDetails: details,
+		EvolvesTo:        next,
+	}
+}
+
+func baby(link ChainLink) ChainLink {
+	link.IsBaby = true
+	return link
+}
+
+func levelUp(level int) EvolutionDetail {
+	return EvolutionDetail{Trigger: NamedResource{Name: "level-up"}, MinLevel: &level}
+}
+
+func friendship(happiness int, timeOfDay string) EvolutionDetail {
+	return EvolutionDetail{Trigger: NamedResource{Name: "level-up"}, MinHappiness: &happiness, TimeOfDay: timeOfDay}
+}
+
+func useItem(item string) EvolutionDetail {
+	return EvolutionDetail{Trigger: NamedResource{Name: "use-item"}, Item: &NamedResource{Name: item}}
+}
+
+func newPokemon(name string, baseExperience, height, weight int, stats []int, types ...string) Pokemon {
+	p := Pokemon{
+		Name:           name,
+		BaseExperience: baseExperience,
+		Height:         height,
+		Weight:         weight,
+		Species:        NamedResource{Name: name},
+		Abilities:      []PokemonAbility{},
+		HeldItems:      []PokemonHeldItem{},
+	}
+	p = p.numbered()
+	for i, stat := range stats {
+		p.Stats = append(p.Stats, Stat{BaseStat: stat, Stat: NamedResource{Name: statNames[i]}})
+	}
+	for _, t := range types {
+		p.Types = append(p.Types, Type{Type: NamedResource{Name: t}})
+	}
+	return p
+}
+
+// numbered gives the Pokemon its species' national dex number and sprites.
+func (p Pokemon) numbered() Pokemon {
+	number, ok := SeedNationalDex[p.Species.Name]
+	if !ok {
+		return p
+	}
+	p.ID, p.Order = number, number
+	front := fmt.Sprintf("sprites/pokemon/%d.png", number)
+	shiny := fmt.Sprintf("sprites/pokemon/shiny/%d.png", number)
+	p.Sprites.FrontDefault, p.Sprites.FrontShiny = &front, &shiny
+	return p
+}
+
+// ofSpecies makes the Pokemon a form of another species, such as
+// wormadam-plant of wormadam.
+func (p Pokemon) ofSpecies(species string) Pokemon {
+	p.Species = NamedResource{Name: species}
+	return p.numbered()
+}
+
+// withEffort sets the EVs of one stat the Pokemon yields when defeated.
+func (p Pokemon) withEffort(stat string, effort int) Pokemon {
+	for i := range p.Stats {
+		if p.Stats[i].Stat.Name == stat {
+			p.Stats[i].Effort = effort
+		}
+	}
+	return p
+}
+
+// withHeldItem lets wild Pokemon hold the item with the given percent
+// rarity in each version.
+func (p Pokemon) withHeldItem(item string, rarity int, versions ...string) Pokemon {
+	held := PokemonHeldItem{Item: NamedResource{Name: item}}
+	for _, v := range versions {
+		held.VersionDetails = append(held.VersionDetails, HeldItemVersionRate{Version: NamedResource{Name: v}, Rarity: rarity})
+	}
+	p.HeldItems = append(p.HeldItems, held)
+	return p
+}
+
+// withAbilities sets the Pokemon's hidden ability followed by its regular
+// ones.
+func (p Pokemon) withAbilities(hidden string, abilities ...string) Pokemon {
+	for i, ability := range abilities {
+		p.Abilities = append(p.Abilities, PokemonAbility{Slot: i + 1, Ability: NamedResource{Name: ability}})
+	}
+	p.Abilities = append(p.Abilities, PokemonAbility{IsHidden: true, Slot: 3, Ability: NamedResource{Name: hidden}})
+	return p
+}
+
+// withMoves lets the Pokemon learn each move at level 1 in
+// DefaultVersionGroup.
+func (p Pokemon) withMoves(moves ...string) Pokemon {
+	return p.learns(DefaultVersionGroup, "level-up", 1, moves...)
+}
+
+// learns sets how the Pokemon learns moves in a version group, replacing
+// what it said for that group before.
+func (p Pokemon) learns(versionGroup, method string, level int, moves ...string) Pokemon {
+	detail := MoveVersionDetail{
+		LevelLearnedAt:  level,
+		MoveLearnMethod: NamedResource{Name: method},
+		VersionGroup:    NamedResource{Name: versionGroup},
+	}
+	for _, move := range moves {
+		i := slices.IndexFunc(p.Moves, func(pm PokemonMove) bool { return pm.Move.Name == move })
+		if i < 0 {
+			p.Moves = append(p.Moves, PokemonMove{Move: NamedResource{Name: move}})
+			i = len(p.Moves) - 1
+		}
+		details := slices.DeleteFunc(p.Moves[i].VersionGroupDetails, func(d MoveVersionDetail) bool {
+			return d.VersionGroup.Name == versionGroup
+		})
+		p.Moves[i].VersionGroupDetails = append(details, detail)
+	}
+	return p
+}
+
+// newMove builds a move; a zero power or accuracy is served as null, the
+// way PokeAPI reports status moves and moves that never miss.
+func newMove(name string, power, accuracy, pp, priority int, moveType, damageClass string) Move {
+	m := Move{
+		Name:        name,
+		PP:          pp,
+		Priority:    priority,
+		Type:        NamedResource{Name: moveType},
+		DamageClass: NamedResource{Name: damageClass},
+	}
+	if power > 0 {
+		m.Power = &power
+	}
+	if accuracy > 0 {
+		m.Accuracy = &accuracy
+	}
+	m.EffectEntries = []VerboseEffect{}
+	return m
+}
+
+func newItem(name string, cost int, category, effect string) Item {
+	return Item{
+		Name:     name,
+		Cost:     cost,
+		Category: NamedResource{Name: category},
+		EffectEntries: []VerboseEffect{
+			{Effect: effect, ShortEffect: effect, Language: NamedResource{Name: "en"}},
+		},
+		Names: []Name{},
+	}
+}
+
+// withName adds the item's name in a language.
+func (i Item) withName(language, name string) Item {
+	i.Names = append(i.Names, Name{Name: name, Language: NamedResource{Name: language}})
+	return i
+}
+
+// newBerry builds a berry whose item is "<name>-berry". Flavors are listed
+// in name order.
+func newBerry(name string, growthTime, giftPower int, giftType, firmness string, flavors map[string]int) Berry {
+	b := Berry{
+		Name:             name,
+		GrowthTime:       growthTime,
+		MaxHarvest:       5,
+		NaturalGiftPower: giftPower,
+		NaturalGiftType:  NamedResource{Name: giftType},
+		Firmness:         NamedResource{Name: firmness},
+		Item:             NamedResource{Name: name + "-berry"},
+	}
+	for _, flavor := range slices.Sorted(maps.Keys(flavors)) {
+		b.Flavors = append(b.Flavors, BerryFlavor{Potency: flavors[flavor], Flavor: NamedResource{Name: flavor}})
+	}
+	return b
+}
+
+// withEffect gives the move English effect text; "$effect_chance" in it
+// stands for chance.
+func (m Move) withEffect(effect string, chance int) Move {
+	if chance > 0 {
+		m.EffectChance = &chance
+	}
+	m.EffectEntries = append(m.EffectEntries, VerboseEffect{
+		Effect:      effect,
+		ShortEffect: effect,
+		Language:    NamedResource{Name: "en"},
+	})
+	return m
+}
+
+// seedTypes expands TypeChart into type resources, filling in the
+// "damage from" relations from the attacking side.
+func seedTypes() []PokemonType {
+	types := make(map[string]*PokemonType)
+	get := func(name string) *PokemonType {
+		if types[name] == nil {
+			types[name] = &PokemonType{Name: name, DamageRelations: DamageRelations{
+				DoubleDamageTo:   []NamedResource{},
+				HalfDamageTo:     []NamedResource{},
+				NoDamageTo:       []NamedResource{},
+				DoubleDamageFrom: []NamedResource{},
+				HalfDamageFrom:   []NamedResource{},
+				NoDamageFrom:     []NamedResource{},
+			}}
+		}
+		return types[name]
+	}
+
+	for attacking, relations := range TypeChart {
+		a := get(attacking)
+		for _, d := range relations[0] {
+			a.DamageRelations.DoubleDamageTo = append(a.DamageRelations.DoubleDamageTo, NamedResource{Name: d})
+			get(d).DamageRelations.DoubleDamageFrom = append(get(d).DamageRelations.DoubleDamageFrom, NamedResource{Name: attacking})
+		}
+		for _, d := range relations[1] {
+			a.DamageRelations.HalfDamageTo = append(a.DamageRelations.HalfDamageTo, NamedResource{Name: d})
+			get(d).DamageRelations.HalfDamageFrom = append(get(d).DamageRelations.HalfDamageFrom, NamedResource{Name: attacking})
+		}
+		for _, d := range relations[2] {
+			a.DamageRelations.NoDamageTo = append(a.DamageRelations.NoDamageTo, NamedResource{Name: d})
+			get(d).DamageRelations.NoDamageFrom = append(get(d).DamageRelations.NoDamageFrom, NamedResource{Name: attacking})
+		}
+	}
+
+	for _, p := range SeedPokemon {
+		for i, t := range p.Types {
+			get(t.Type.Name).Pokemon = append(get(t.Type.Name).Pokemon, TypePokemon{Slot: i + 1, Pokemon: NamedResource{Name: p.Name}})
+		}
+	}
+
+	result := make([]PokemonType, 0, len(types))
+	for _, t := range types {
+		if t.Pokemon == nil {
+			t.Pokemon = []TypePokemon{}
+		}
+		result = append(result, *t)
+	}
+	return result
+}
+
+// seedAbilities builds an ability resource for every ability a seeded
+// Pokemon has, listing the Pokemon that can have it.
+func seedAbilities() []Ability {
+	abilities := make(map[string]*Ability)
+	for _, p := range SeedPokemon {
+		for _, pa := range p.Abilities {
+			a := abilities[pa.Ability.Name]
+			if a == nil {
+				a = &Ability{Name: pa.Ability.Name, EffectEntries: []VerboseEffect{}}
+				if effect, ok := SeedAbilityEffects[a.Name]; ok {
+					a.EffectEntries = append(a.EffectEntries, VerboseEffect{
+						Effect:      effect,
+						ShortEffect: effect,
+						Language:    NamedResource{Name: "en"},
+					})
+				}
+				abilities[a.Name] = a
+			}
+			a.Pokemon = append(a.Pokemon, AbilityPokemon{IsHidden: pa.IsHidden, Slot: pa.Slot, Pokemon: NamedResource{Name: p.Name}})
+		}
+	}
+
+	result := make([]Ability, 0, len(abilities))
+	for _, a := range abilities {
+		result = append(result, *a)
+	}
+	return result
+}
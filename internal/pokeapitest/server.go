@@ -0,0 +1,503 @@
+// Package pokeapitest provides a fake PokeAPI server for tests.
+//
+// The server is seeded with a handful of Pokemon and location areas that
+// behave like their PokeAPI counterparts, supports offset/limit pagination
+// on listing endpoints, and can be told to slow down or fail so that client
+// and REPL tests can exercise error paths against one shared stand-in.
+package pokeapitest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultLimit = 20
+
+// DefaultVersion is the game version seeded encounters belong to unless
+// they say otherwise.
+const DefaultVersion = "diamond"
+
+// DefaultVersionGroup is the version group seeded learnsets belong to
+// unless they say otherwise.
+const DefaultVersionGroup = "diamond-pearl"
+
+type NamedResource struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+type Stat struct {
+	BaseStat int           `json:"base_stat"`
+	Effort   int           `json:"effort"`
+	Stat     NamedResource `json:"stat"`
+}
+
+type PokemonAbility struct {
+	IsHidden bool          `json:"is_hidden"`
+	Slot     int           `json:"slot"`
+	Ability  NamedResource `json:"ability"`
+}
+
+type Type struct {
+	Type NamedResource `json:"type"`
+}
+
+type Pokemon struct {
+	ID             int               `json:"id"`
+	Name           string            `json:"name"`
+	Order          int               `json:"order"`
+	BaseExperience int               `json:"base_experience"`
+	Height         int               `json:"height"`
+	Weight         int               `json:"weight"`
+	Species        NamedResource     `json:"species"`
+	Abilities      []PokemonAbility  `json:"abilities"`
+	Stats          []Stat            `json:"stats"`
+	Types          []Type            `json:"types"`
+	Moves          []PokemonMove     `json:"moves"`
+	HeldItems      []PokemonHeldItem `json:"held_items"`
+	Sprites        Sprites           `json:"sprites"`
+}
+
+// PokemonHeldItem is an item a wild Pokemon may hold, with its rarity per
+// game version.
+type PokemonHeldItem struct {
+	Item           NamedResource         `json:"item"`
+	VersionDetails []HeldItemVersionRate `json:"version_details"`
+}
+
+type HeldItemVersionRate struct {
+	Version NamedResource `json:"version"`
+	Rarity  int           `json:"rarity"`
+}
+
+// Sprites holds sprite paths relative to the server, which AddPokemon
+// turns into URLs and serves images for.
+type Sprites struct {
+	FrontDefault *string `json:"front_default"`
+	FrontShiny   *string `json:"front_shiny"`
+}
+
+type APIResource struct {
+	URL string `json:"url"`
+}
+
+type PokemonSpecies struct {
+	Name              string                  `json:"name"`
+	CaptureRate       int                     `json:"capture_rate"`
+	BaseHappiness     int                     `json:"base_happiness"`
+	GrowthRate        NamedResource           `json:"growth_rate"`
+	EvolutionChain    APIResource             `json:"evolution_chain"`
+	Varieties         []PokemonSpeciesVariety `json:"varieties"`
+	Names             []Name                  `json:"names"`
+	Genera            []Genus                 `json:"genera"`
+	FlavorTextEntries []FlavorText            `json:"flavor_text_entries"`
+}
+
+// PokemonSpeciesVariety is one of the Pokemon, such as a form, that belong
+// to a species.
+type PokemonSpeciesVariety struct {
+	IsDefault bool          `json:"is_default"`
+	Pokemon   NamedResource `json:"pokemon"`
+}
+
+type Name struct {
+	Name     string        `json:"name"`
+	Language NamedResource `json:"language"`
+}
+
+type Genus struct {
+	Genus    string        `json:"genus"`
+	Language NamedResource `json:"language"`
+}
+
+type FlavorText struct {
+	FlavorText string        `json:"flavor_text"`
+	Language   NamedResource `json:"language"`
+	Version    NamedResource `json:"version"`
+}
+
+type Item struct {
+	Name          string          `json:"name"`
+	Cost          int             `json:"cost"`
+	Category      NamedResource   `json:"category"`
+	EffectEntries []VerboseEffect `json:"effect_entries"`
+	Names         []Name          `json:"names"`
+}
+
+// Encounter is one encounter slot: a method, a chance and a level range.
+type Encounter struct {
+	MinLevel int           `json:"min_level"`
+	MaxLevel int           `json:"max_level"`
+	Chance   int           `json:"chance"`
+	Method   NamedResource `json:"method"`
+}
+
+type VersionEncounterDetail struct {
+	Version          NamedResource `json:"version"`
+	MaxChance        int           `json:"max_chance"`
+	EncounterDetails []Encounter   `json:"encounter_details"`
+}
+
+type PokemonEncounter struct {
+	Pokemon        NamedResource            `json:"pokemon"`
+	VersionDetails []VersionEncounterDetail `json:"version_details"`
+}
+
+// LocationAreaEncounter is one entry of /pokemon/{name}/encounters.
+type LocationAreaEncounter struct {
+	LocationArea   NamedResource            `json:"location_area"`
+	VersionDetails []VersionEncounterDetail `json:"version_details"`
+}
+
+type locationArea struct {
+	Name              string             `json:"name"`
+	Location          *NamedResource     `json:"location"`
+	PokemonEncounters []PokemonEncounter `json:"pokemon_encounters"`
+}
+
+type listResponse struct {
+	Count    int             `json:"count"`
+	Next     *string         `json:"next"`
+	Previous *string         `json:"previous"`
+	Results  []NamedResource `json:"results"`
+}
+
+type failure struct {
+	status    int
+	malformed bool
+}
+
+// Server is a fake PokeAPI. Resources are addressed by their path relative
+// to the API root, e.g. "pokemon/pikachu" or "location-area".
+type Server struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	resources map[string][]byte
+	// contentTypes overrides the JSON content type for files.
+	contentTypes map[string]string
+	lists        map[string][]string
+	failures     map[string]failure
+	latency      time.Duration
+	requests     map[string]int
+	total        int
+	// areaLocations maps each location area to its parent location.
+	areaLocations map[string]string
+	// pokemonAreas indexes area encounters by Pokemon.
+	pokemonAreas map[string][]LocationAreaEncounter
+}
+
+// NewServer starts a fake PokeAPI seeded with default data. Callers must
+// Close it when done.
+func NewServer() *Server {
+	s := NewEmptyServer()
+	seed(s)
+	return s
+}
+
+// NewEmptyServer starts a fake PokeAPI with no resources.
+func NewEmptyServer() *Server {
+	s := &Server{
+		resources:    make(map[string][]byte),
+		contentTypes: make(map[string]string),
+		lists:        make(map[string][]string),
+		failures:     make(map[string]failure),
+		requests:     make(map[string]int),
+
+		areaLocations: make(map[string]string),
+		pokemonAreas:  make(map[string][]LocationAreaEncounter),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// BaseURL returns the API root with a trailing slash, suitable for
+// pokeapi.NewClientWithBaseURL.
+func (s *Server) BaseURL() string {
+	return s.URL + "/"
+}
+
+// AddResource serves v as JSON at path and lists it under the path's
+// collection, so "pokemon/pikachu" also appears in "pokemon".
+func (s *Server) AddResource(path string, v interface{}) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Sprintf("pokeapitest: marshaling %s: %v", path, err))
+	}
+	s.AddRaw(path, string(body))
+}
+
+// AddRaw serves body verbatim at path.
+func (s *Server) AddRaw(path, body string) {
+	path = cleanPath(path)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.resources[path]; !exists {
+		if i := strings.LastIndex(path, "/"); i > 0 {
+			collection, name := path[:i], path[i+1:]
+			s.lists[collection] = append(s.lists[collection], name)
+		}
+	}
+	s.resources[path] = []byte(body)
+}
+
+// AddFile serves data with the given content type at path without listing
+// it in any collection.
+func (s *Server) AddFile(path, contentType string, data []byte) {
+	path = cleanPath(path)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resources[path] = data
+	s.contentTypes[path] = contentType
+}
+
+// spritePNG draws a placeholder sprite: a square in a color derived from
+// the Pokemon's name, inverted for the shiny variant, on a transparent
+// background.
+func spritePNG(name string, shiny bool) []byte {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	sum := h.Sum32()
+	c := color.NRGBA{R: uint8(sum), G: uint8(sum >> 8), B: uint8(sum >> 16), A: 255}
+	if shiny {
+		c.R, c.G, c.B = 255-c.R, 255-c.G, 255-c.B
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, 12, 12))
+	for y := 3; y < 9; y++ {
+		for x := 3; x < 9; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		panic(fmt.Sprintf("pokeapitest: encoding sprite for %s: %v", name, err))
+	}
+	return buf.Bytes()
+}
+
+// AddPokemon serves p along with where it can be encountered and its
+// sprites.
+func (s *Server) AddPokemon(p Pokemon) {
+	for i, sprite := range []**string{&p.Sprites.FrontDefault, &p.Sprites.FrontShiny} {
+		if *sprite == nil || strings.HasPrefix(**sprite, "http") {
+			continue
+		}
+		path := **sprite
+		s.AddFile(path, "image/png", spritePNG(p.Name, i == 1))
+		url := s.BaseURL() + path
+		*sprite = &url
+	}
+	s.AddResource("pokemon/"+p.Name, p)
+	s.servePokemonEncounters(p.Name)
+}
+
+func (s *Server) AddSpecies(species PokemonSpecies) {
+	s.AddResource("pokemon-species/"+species.Name, species)
+}
+
+func (s *Server) AddItem(item Item) {
+	s.AddResource("item/"+item.Name, item)
+}
+
+// AddLocationArea serves a location area whose encounters are the named
+// Pokemon, each found by walking in DefaultVersion with the same chance.
+func (s *Server) AddLocationArea(name string, pokemon ...string) {
+	var encounters []PokemonEncounter
+	for _, p := range pokemon {
+		encounters = append(encounters, NewPokemonEncounter(p, DefaultVersion,
+			Encounter{MinLevel: 5, MaxLevel: 10, Chance: 100 / len(pokemon), Method: NamedResource{Name: "walk"}}))
+	}
+	s.AddLocationAreaEncounters(name, encounters...)
+}
+
+// AddLocationAreaEncounters serves a location area with full encounter
+// data. Locations should be added first so the area can link to its
+// parent.
+func (s *Server) AddLocationAreaEncounters(name string, encounters ...PokemonEncounter) {
+	area := locationArea{Name: name, PokemonEncounters: []PokemonEncounter{}}
+	s.mu.Lock()
+	location, ok := s.areaLocations[name]
+	s.mu.Unlock()
+	if ok {
+		area.Location = &NamedResource{Name: location, URL: s.BaseURL() + "location/" + location + "/"}
+	}
+	for _, e := range encounters {
+		e.Pokemon.URL = s.BaseURL() + "pokemon/" + e.Pokemon.Name + "/"
+		area.PokemonEncounters = append(area.PokemonEncounters, e)
+	}
+	s.AddResource("location-area/"+name, area)
+
+	for _, e := range encounters {
+		s.mu.Lock()
+		s.pokemonAreas[e.Pokemon.Name] = append(s.pokemonAreas[e.Pokemon.Name], LocationAreaEncounter{
+			LocationArea:   NamedResource{Name: name, URL: s.BaseURL() + "location-area/" + name + "/"},
+			VersionDetails: e.VersionDetails,
+		})
+		s.mu.Unlock()
+		s.servePokemonEncounters(e.Pokemon.Name)
+	}
+}
+
+// servePokemonEncounters serves /pokemon/{name}/encounters from the areas
+// added so far.
+func (s *Server) servePokemonEncounters(pokemon string) {
+	s.mu.Lock()
+	encounters := append([]LocationAreaEncounter{}, s.pokemonAreas[pokemon]...)
+	s.mu.Unlock()
+	s.AddResource("pokemon/"+pokemon+"/encounters", encounters)
+}
+
+// NewPokemonEncounter builds the encounters for one Pokemon in one game
+// version.
+func NewPokemonEncounter(pokemon, version string, slots ...Encounter) PokemonEncounter {
+	detail := VersionEncounterDetail{
+		Version:          NamedResource{Name: version},
+		EncounterDetails: slots,
+	}
+	for _, slot := range slots {
+		detail.MaxChance += slot.Chance
+	}
+	return PokemonEncounter{
+		Pokemon:        NamedResource{Name: pokemon},
+		VersionDetails: []VersionEncounterDetail{detail},
+	}
+}
+
+// SetLatency delays every response by d.
+func (s *Server) SetLatency(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = d
+}
+
+// FailWith makes requests to path respond with status. An empty path fails
+// every request.
+func (s *Server) FailWith(path string, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[cleanPath(path)] = failure{status: status}
+}
+
+// ServeMalformed makes requests to path respond with invalid JSON. An empty
+// path affects every request.
+func (s *Server) ServeMalformed(path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[cleanPath(path)] = failure{malformed: true}
+}
+
+// ClearFailures removes all injected errors.
+func (s *Server) ClearFailures() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = make(map[string]failure)
+}
+
+// Requests returns how many requests were made to path.
+func (s *Server) Requests(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[cleanPath(path)]
+}
+
+// TotalRequests returns how many requests the server has handled.
+func (s *Server) TotalRequests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.total
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	path := cleanPath(r.URL.Path)
+
+	s.mu.Lock()
+	s.requests[path]++
+	s.total++
+	latency := s.latency
+	f, failing := s.failures[path]
+	if !failing {
+		f, failing = s.failures[""]
+	}
+	body, found := s.resources[path]
+	contentType, isFile := s.contentTypes[path]
+	names, isList := s.lists[path]
+	names = append([]string(nil), names...)
+	s.mu.Unlock()
+
+	if latency > 0 {
+		time.Sleep(latency)
+	}
+
+	if !isFile {
+		contentType = "application/json"
+	}
+	w.Header().Set("Content-Type", contentType)
+	switch {
+	case failing && f.malformed:
+		fmt.Fprint(w, `{"this is": not json`)
+	case failing:
+		w.WriteHeader(f.status)
+	case found:
+		w.Write(body)
+	case isList:
+		s.writeList(w, r, path, names)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, "Not Found")
+	}
+}
+
+func (s *Server) writeList(w http.ResponseWriter, r *http.Request, path string, names []string) {
+	offset := queryInt(r, "offset", 0)
+	limit := queryInt(r, "limit", defaultLimit)
+	if limit == 0 {
+		limit = defaultLimit
+	}
+
+	sort.Strings(names)
+	resp := listResponse{Count: len(names), Results: []NamedResource{}}
+	for i := offset; i < len(names) && i < offset+limit; i++ {
+		resp.Results = append(resp.Results, NamedResource{
+			Name: names[i],
+			URL:  s.BaseURL() + path + "/" + names[i] + "/",
+		})
+	}
+	if offset+limit < len(names) {
+		next := fmt.Sprintf("%s%s?offset=%d&limit=%d", s.BaseURL(), path, offset+limit, limit)
+		resp.Next = &next
+	}
+	if offset > 0 {
+		prev := fmt.Sprintf("%s%s?offset=%d&limit=%d", s.BaseURL(), path, max(0, offset-limit), limit)
+		resp.Previous = &prev
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+func queryInt(r *http.Request, key string, fallback int) int {
+	n, err := strconv.Atoi(r.URL.Query().Get(key))
+	if err != nil || n < 0 {
+		return fallback
+	}
+	return n
+}
+
+func cleanPath(path string) string {
+	return strings.Trim(path, "/")
+}
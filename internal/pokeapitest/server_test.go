@@ -0,0 +1,201 @@
+package pokeapitest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func get(t *testing.T, url string) (int, []byte) {
+	t.Helper()
+	res, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("GET %s: %v", url, err)
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatalf("reading %s: %v", url, err)
+	}
+	return res.StatusCode, body
+}
+
+func TestServer_SeededPokemon(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	status, body := get(t, server.BaseURL()+"pokemon/pikachu")
+	assert.Equal(t, http.StatusOK, status)
+
+	var p Pokemon
+	assert.NoError(t, json.Unmarshal(body, &p))
+	assert.Equal(t, "pikachu", p.Name)
+	assert.Equal(t, 112, p.BaseExperience)
+	assert.Equal(t, "electric", p.Types[0].Type.Name)
+}
+
+func TestServer_TrailingSlash(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	status, _ := get(t, server.BaseURL()+"pokemon/pikachu/")
+	assert.Equal(t, http.StatusOK, status)
+}
+
+func TestServer_NotFound(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	status, _ := get(t, server.BaseURL()+"pokemon/fakemon")
+	assert.Equal(t, http.StatusNotFound, status)
+}
+
+func TestServer_LocationAreaEncounters(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	_, body := get(t, server.BaseURL()+"location-area/canalave-city-area")
+
+	var area locationArea
+	assert.NoError(t, json.Unmarshal(body, &area))
+	assert.Len(t, area.PokemonEncounters, 2)
+	assert.Equal(t, "tentacool", area.PokemonEncounters[0].Pokemon.Name)
+}
+
+func TestServer_EveryAreaHasALocationAndRegion(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	for name := range SeedLocationAreas {
+		_, body := get(t, server.BaseURL()+"location-area/"+name)
+		var area locationArea
+		assert.NoError(t, json.Unmarshal(body, &area))
+		if !assert.NotNil(t, area.Location, name) {
+			continue
+		}
+
+		status, body := get(t, area.Location.URL)
+		assert.Equal(t, http.StatusOK, status)
+		var location Location
+		assert.NoError(t, json.Unmarshal(body, &location))
+		assert.NotNil(t, location.Region, location.Name)
+	}
+}
+
+func TestServer_Pagination(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	_, body := get(t, server.BaseURL()+"location-area")
+	var first listResponse
+	assert.NoError(t, json.Unmarshal(body, &first))
+	assert.Equal(t, len(SeedLocationAreas), first.Count)
+	assert.Len(t, first.Results, defaultLimit)
+	assert.Nil(t, first.Previous)
+	assert.NotNil(t, first.Next)
+
+	_, body = get(t, *first.Next)
+	var second listResponse
+	assert.NoError(t, json.Unmarshal(body, &second))
+	assert.Len(t, second.Results, len(SeedLocationAreas)-defaultLimit)
+	assert.Nil(t, second.Next)
+	assert.NotNil(t, second.Previous)
+
+	_, body = get(t, *second.Previous)
+	var back listResponse
+	assert.NoError(t, json.Unmarshal(body, &back))
+	assert.Equal(t, first.Results, back.Results)
+}
+
+func TestServer_ErrorInjection(t *testing.T) {
+	cases := []struct {
+		name   string
+		status int
+	}{
+		{"not found", http.StatusNotFound},
+		{"server error", http.StatusInternalServerError},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			server := NewServer()
+			defer server.Close()
+
+			server.FailWith("pokemon/pikachu", c.status)
+			status, _ := get(t, server.BaseURL()+"pokemon/pikachu")
+			assert.Equal(t, c.status, status)
+
+			status, _ = get(t, server.BaseURL()+"pokemon/bulbasaur")
+			assert.Equal(t, http.StatusOK, status)
+
+			server.ClearFailures()
+			status, _ = get(t, server.BaseURL()+"pokemon/pikachu")
+			assert.Equal(t, http.StatusOK, status)
+		})
+	}
+}
+
+func TestServer_FailEverything(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	server.FailWith("", http.StatusServiceUnavailable)
+	status, _ := get(t, server.BaseURL()+"location-area")
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+}
+
+func TestServer_ServeMalformed(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	server.ServeMalformed("pokemon/pikachu")
+	status, body := get(t, server.BaseURL()+"pokemon/pikachu")
+	assert.Equal(t, http.StatusOK, status)
+
+	var p Pokemon
+	assert.Error(t, json.Unmarshal(body, &p))
+}
+
+func TestServer_Latency(t *testing.T) {
+	const latency = 20 * time.Millisecond
+	server := NewServer()
+	defer server.Close()
+
+	server.SetLatency(latency)
+	start := time.Now()
+	get(t, server.BaseURL()+"pokemon/pikachu")
+	assert.GreaterOrEqual(t, time.Since(start), latency)
+}
+
+func TestServer_RequestCounting(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	get(t, server.BaseURL()+"pokemon/pikachu")
+	get(t, server.BaseURL()+"pokemon/pikachu/")
+	get(t, server.BaseURL()+"pokemon/bulbasaur")
+
+	assert.Equal(t, 2, server.Requests("pokemon/pikachu"))
+	assert.Equal(t, 1, server.Requests("/pokemon/bulbasaur"))
+	assert.Equal(t, 0, server.Requests("pokemon/squirtle"))
+	assert.Equal(t, 3, server.TotalRequests())
+}
+
+func TestServer_AddRaw(t *testing.T) {
+	server := NewEmptyServer()
+	defer server.Close()
+
+	server.AddRaw("pokemon-species/pikachu", `{"capture_rate": 190}`)
+	_, body := get(t, server.BaseURL()+"pokemon-species/pikachu")
+	assert.JSONEq(t, `{"capture_rate": 190}`, string(body))
+
+	_, body = get(t, server.BaseURL()+"pokemon-species")
+	var list listResponse
+	assert.NoError(t, json.Unmarshal(body, &list))
+	assert.Equal(t, 1, list.Count)
+	assert.Equal(t, "pikachu", list.Results[0].Name)
+}
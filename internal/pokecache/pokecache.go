@@ -11,7 +11,7 @@ type cacheEntry struct {
 }
 
 type Cache struct {
-	mu         sync.Mutex
+	mu         *sync.Mutex
 	cacheEntry map[string]cacheEntry
 	interval   time.Duration
 }
@@ -59,6 +59,7 @@ func (c *Cache) reapLoop(ticker *time.Ticker) {
 
 func NewCache(interval time.Duration) Cache {
 	c := Cache{}
+	c.mu = &sync.Mutex{}
 	c.cacheEntry = make(map[string]cacheEntry)
 	c.interval = interval
 	t := time.NewTicker(interval)
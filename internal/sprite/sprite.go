@@ -0,0 +1,142 @@
+// Package sprite draws Pokemon sprites in the terminal.
+//
+// Truecolor output packs two pixels into each character cell with the
+// upper half block, using the foreground color for the top pixel and the
+// background color for the bottom one. The ASCII fallback maps brightness
+// to characters for terminals without color.
+package sprite
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+)
+
+// DefaultMaxWidth keeps rendered sprites narrower than most terminals.
+const DefaultMaxWidth = 64
+
+// asciiRamp runs from the character for the darkest pixels to the one for
+// the brightest, as seen on a dark terminal. Every opaque pixel gets a
+// visible character so outlines survive.
+const asciiRamp = ".:-=+*#%@"
+
+const (
+	upperHalf = "▀"
+	lowerHalf = "▄"
+	reset     = "\x1b[0m"
+)
+
+type Options struct {
+	// ASCII renders plain characters instead of truecolor blocks.
+	ASCII bool
+	// MaxWidth is the widest the sprite may be in columns. Wider sprites
+	// are scaled down; zero means DefaultMaxWidth.
+	MaxWidth int
+}
+
+// Decode reads a PNG sprite.
+func Decode(data []byte) (image.Image, error) {
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding sprite: %w", err)
+	}
+	return img, nil
+}
+
+// Render draws img, cropped to its visible pixels, one line per two pixel
+// rows.
+func Render(img image.Image, opts Options) string {
+	if opts.MaxWidth <= 0 {
+		opts.MaxWidth = DefaultMaxWidth
+	}
+	bounds := visibleBounds(img)
+	if bounds.Empty() {
+		return ""
+	}
+	step := (bounds.Dx() + opts.MaxWidth - 1) / opts.MaxWidth
+
+	var b strings.Builder
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += 2 * step {
+		for x := bounds.Min.X; x < bounds.Max.X; x += step {
+			top := img.At(x, y)
+			bottom := color.Color(color.Transparent)
+			if y+step < bounds.Max.Y {
+				bottom = img.At(x, y+step)
+			}
+			if opts.ASCII {
+				b.WriteString(asciiCell(top, bottom))
+			} else {
+				b.WriteString(blockCell(top, bottom))
+			}
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func opaque(c color.Color) bool {
+	_, _, _, a := c.RGBA()
+	return a >= 0x8000
+}
+
+// visibleBounds is the smallest rectangle holding every opaque pixel.
+func visibleBounds(img image.Image) image.Rectangle {
+	var visible image.Rectangle
+	b := img.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if opaque(img.At(x, y)) {
+				visible = visible.Union(image.Rect(x, y, x+1, y+1))
+			}
+		}
+	}
+	return visible
+}
+
+func rgb(c color.Color) (uint8, uint8, uint8) {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return n.R, n.G, n.B
+}
+
+func blockCell(top, bottom color.Color) string {
+	switch {
+	case opaque(top) && opaque(bottom):
+		tr, tg, tb := rgb(top)
+		br, bg, bb := rgb(bottom)
+		return fmt.Sprintf("\x1b[38;2;%d;%d;%dm\x1b[48;2;%d;%d;%dm%s%s", tr, tg, tb, br, bg, bb, upperHalf, reset)
+	case opaque(top):
+		r, g, b := rgb(top)
+		return fmt.Sprintf("\x1b[38;2;%d;%d;%dm%s%s", r, g, b, upperHalf, reset)
+	case opaque(bottom):
+		r, g, b := rgb(bottom)
+		return fmt.Sprintf("\x1b[38;2;%d;%d;%dm%s%s", r, g, b, lowerHalf, reset)
+	}
+	return " "
+}
+
+// asciiCell picks a character for the average brightness of the opaque
+// pixels in a cell.
+func asciiCell(top, bottom color.Color) string {
+	total, n := 0.0, 0
+	for _, c := range []color.Color{top, bottom} {
+		if !opaque(c) {
+			continue
+		}
+		total += luminance(c)
+		n++
+	}
+	if n == 0 {
+		return " "
+	}
+	i := int(total / float64(n) * float64(len(asciiRamp)-1))
+	return string(asciiRamp[i])
+}
+
+// luminance is perceived brightness from 0 to 1.
+func luminance(c color.Color) float64 {
+	r, g, b := rgb(c)
+	return (0.2126*float64(r) + 0.7152*float64(g) + 0.0722*float64(b)) / 255
+}
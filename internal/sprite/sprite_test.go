@@ -0,0 +1,64 @@
+package sprite
+
+import (
+	"image"
+	"image/color"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func loadFixture(t *testing.T) image.Image {
+	t.Helper()
+	data, err := os.ReadFile("testdata/fixture.png")
+	assert.NoError(t, err)
+	img, err := Decode(data)
+	assert.NoError(t, err)
+	return img
+}
+
+func TestRender_TrueColorHalfBlocks(t *testing.T) {
+	out := Render(loadFixture(t), Options{})
+
+	lines := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+	// The 4x2 visible block fits in one row of four cells.
+	assert.Len(t, lines, 1)
+	assert.Equal(t, 4, strings.Count(lines[0], upperHalf))
+	assert.True(t, strings.HasPrefix(lines[0], "\x1b[38;2;255;0;0m\x1b[48;2;0;0;255m"+upperHalf+reset))
+	assert.True(t, strings.HasSuffix(lines[0], "\x1b[38;2;255;0;0m\x1b[48;2;255;255;255m"+upperHalf+reset))
+}
+
+func TestRender_ASCII(t *testing.T) {
+	out := Render(loadFixture(t), Options{ASCII: true})
+	// Red over blue is dim; red over white is brighter.
+	assert.Equal(t, ":::+\n", out)
+}
+
+func TestRender_ScalesAndHandlesHalfTransparentCells(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 8, 6))
+	for x := 0; x < 8; x++ {
+		for _, y := range []int{0, 2, 4} {
+			img.Set(x, y, color.NRGBA{0, 255, 0, 255})
+		}
+	}
+
+	// Halving the width also halves the height, so rows 0, 2 and 4 are
+	// sampled and the last has nothing below it.
+	out := Render(img, Options{MaxWidth: 4})
+	lines := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+	assert.Len(t, lines, 2)
+	assert.Equal(t, 4, strings.Count(lines[0], "\x1b[48;2;0;255;0m"))
+	assert.Equal(t, 4, strings.Count(lines[1], upperHalf))
+	assert.NotContains(t, lines[1], "\x1b[48;2")
+}
+
+func TestRender_Empty(t *testing.T) {
+	assert.Equal(t, "", Render(image.NewNRGBA(image.Rect(0, 0, 4, 4)), Options{}))
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	_, err := Decode([]byte("not a png"))
+	assert.Error(t, err)
+}
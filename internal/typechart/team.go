@@ -0,0 +1,87 @@
+package typechart
+
+import (
+	"github.com/danalytis/pokedexcli/internal/pokeapi"
+)
+
+// TypeCoverage lists which team members are weak to, resist or are immune
+// to one attacking type, or which members hit one defending type super
+// effectively with a STAB type.
+type TypeCoverage struct {
+	Type   string   `json:"type"`
+	Weak   []string `json:"weak,omitempty"`
+	Resist []string `json:"resist,omitempty"`
+	Immune []string `json:"immune,omitempty"`
+	HitBy  []string `json:"hit_by,omitempty"`
+}
+
+type TeamAnalysis struct {
+	Members []string       `json:"members"`
+	Defense []TypeCoverage `json:"defense"`
+	Offense []TypeCoverage `json:"offense"`
+	// Threats are attacking types that at least two members are weak to
+	// and that more members are weak to than resist.
+	Threats []string `json:"threats"`
+	// Uncovered are defending types no member hits super effectively with
+	// its own types.
+	Uncovered []string `json:"uncovered"`
+}
+
+// AnalyzeTeam checks every type against the team, defensively using each
+// member's types and offensively using the types it gets STAB on.
+func (c *Chart) AnalyzeTeam(team []pokeapi.Pokemon) (TeamAnalysis, error) {
+	types, err := c.Types()
+	if err != nil {
+		return TeamAnalysis{}, err
+	}
+
+	analysis := TeamAnalysis{
+		Members:   []string{},
+		Threats:   []string{},
+		Uncovered: []string{},
+	}
+	for _, member := range team {
+		analysis.Members = append(analysis.Members, member.Name)
+	}
+
+	for _, t := range types {
+		defense := TypeCoverage{Type: t}
+		offense := TypeCoverage{Type: t}
+
+		for _, member := range team {
+			m, err := c.Effectiveness(t, TypeNames(member))
+			if err != nil {
+				return TeamAnalysis{}, err
+			}
+			switch {
+			case m == 0:
+				defense.Immune = append(defense.Immune, member.Name)
+			case m < 1:
+				defense.Resist = append(defense.Resist, member.Name)
+			case m > 1:
+				defense.Weak = append(defense.Weak, member.Name)
+			}
+
+			for _, own := range TypeNames(member) {
+				m, err := c.Multiplier(own, t)
+				if err != nil {
+					return TeamAnalysis{}, err
+				}
+				if m > 1 {
+					offense.HitBy = append(offense.HitBy, member.Name)
+					break
+				}
+			}
+		}
+
+		analysis.Defense = append(analysis.Defense, defense)
+		analysis.Offense = append(analysis.Offense, offense)
+		if len(defense.Weak) >= 2 && len(defense.Weak) > len(defense.Resist)+len(defense.Immune) {
+			analysis.Threats = append(analysis.Threats, t)
+		}
+		if len(offense.HitBy) == 0 {
+			analysis.Uncovered = append(analysis.Uncovered, t)
+		}
+	}
+	return analysis, nil
+}
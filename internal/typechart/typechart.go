@@ -0,0 +1,186 @@
+// Package typechart builds the type effectiveness matrix from PokeAPI's
+// damage relations and answers matchup questions with it.
+package typechart
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/danalytis/pokedexcli/internal/pokeapi"
+)
+
+// Chart maps attacking type to defending type to damage multiplier. Rows are
+// fetched from PokeAPI as they are needed, or all at once by LoadAll.
+type Chart struct {
+	client *pokeapi.Client
+	rows   map[string]map[string]float64
+	types  []string
+}
+
+func New(client *pokeapi.Client) *Chart {
+	return &Chart{
+		client: client,
+		rows:   make(map[string]map[string]float64),
+	}
+}
+
+// Types returns every type name, loading the full chart if necessary.
+func (c *Chart) Types() ([]string, error) {
+	if err := c.LoadAll(); err != nil {
+		return nil, err
+	}
+	return c.types, nil
+}
+
+// LoadAll fetches the damage relations of every type.
+func (c *Chart) LoadAll() error {
+	if c.types != nil {
+		return nil
+	}
+
+	list, err := c.client.ListTypes()
+	if err != nil {
+		return err
+	}
+	var types []string
+	for _, t := range list {
+		row, err := c.row(t.Name)
+		if err != nil {
+			return err
+		}
+		// PokeAPI lists placeholder types like "unknown" and "shadow" that
+		// no Pokemon or move interacts with.
+		if len(row) > 0 {
+			types = append(types, t.Name)
+		}
+	}
+	sort.Strings(types)
+	c.types = types
+	return nil
+}
+
+func (c *Chart) row(attacking string) (map[string]float64, error) {
+	if row, ok := c.rows[attacking]; ok {
+		return row, nil
+	}
+
+	t, err := c.client.GetType(attacking)
+	if err != nil {
+		return nil, fmt.Errorf("unknown type %s: %w", attacking, err)
+	}
+	row := make(map[string]float64)
+	for _, r := range t.DamageRelations.DoubleDamageTo {
+		row[r.Name] = 2
+	}
+	for _, r := range t.DamageRelations.HalfDamageTo {
+		row[r.Name] = 0.5
+	}
+	for _, r := range t.DamageRelations.NoDamageTo {
+		row[r.Name] = 0
+	}
+	c.rows[attacking] = row
+	return row, nil
+}
+
+// Multiplier returns the multiplier of an attacking type against a single
+// defending type.
+func (c *Chart) Multiplier(attacking, defending string) (float64, error) {
+	row, err := c.row(attacking)
+	if err != nil {
+		return 0, err
+	}
+	if m, ok := row[defending]; ok {
+		return m, nil
+	}
+	return 1, nil
+}
+
+// Effectiveness returns the combined multiplier of an attacking type
+// against a Pokemon with one or two defending types.
+func (c *Chart) Effectiveness(attacking string, defending []string) (float64, error) {
+	multiplier := 1.0
+	for _, d := range defending {
+		m, err := c.Multiplier(attacking, d)
+		if err != nil {
+			return 0, err
+		}
+		multiplier *= m
+	}
+	return multiplier, nil
+}
+
+// Summary describes a single type both as an attacker and a defender.
+type Summary struct {
+	Name                  string
+	SuperEffectiveAgainst []string
+	NotVeryEffective      []string
+	NoEffectAgainst       []string
+	WeakTo                []string
+	Resists               []string
+	ImmuneTo              []string
+}
+
+func (c *Chart) Summarize(name string) (Summary, error) {
+	types, err := c.Types()
+	if err != nil {
+		return Summary{}, err
+	}
+	if _, err := c.row(name); err != nil {
+		return Summary{}, err
+	}
+
+	s := Summary{Name: name}
+	for _, other := range types {
+		offense, _ := c.Multiplier(name, other)
+		switch offense {
+		case 2:
+			s.SuperEffectiveAgainst = append(s.SuperEffectiveAgainst, other)
+		case 0.5:
+			s.NotVeryEffective = append(s.NotVeryEffective, other)
+		case 0:
+			s.NoEffectAgainst = append(s.NoEffectAgainst, other)
+		}
+
+		defense, _ := c.Multiplier(other, name)
+		switch defense {
+		case 2:
+			s.WeakTo = append(s.WeakTo, other)
+		case 0.5:
+			s.Resists = append(s.Resists, other)
+		case 0:
+			s.ImmuneTo = append(s.ImmuneTo, other)
+		}
+	}
+	return s, nil
+}
+
+// TypeNames returns the names in a Pokemon's Types slice.
+func TypeNames(p pokeapi.Pokemon) []string {
+	names := make([]string, 0, len(p.Types))
+	for _, t := range p.Types {
+		names = append(names, t.Type.Name)
+	}
+	return names
+}
+
+// Matchup is how well one Pokemon's types hit another's.
+type Matchup struct {
+	AttackingType string
+	Multiplier    float64
+}
+
+// Matchups returns the multiplier of each of the attacker's types against
+// the defender.
+func (c *Chart) Matchups(attacker, defender pokeapi.Pokemon) ([]Matchup, error) {
+	defending := TypeNames(defender)
+
+	var matchups []Matchup
+	for _, t := range TypeNames(attacker) {
+		m, err := c.Effectiveness(t, defending)
+		if err != nil {
+			return nil, err
+		}
+		matchups = append(matchups, Matchup{AttackingType: t, Multiplier: m})
+	}
+	return matchups, nil
+}
@@ -0,0 +1,157 @@
+package typechart
+
+import (
+	"testing"
+	"time"
+
+	"github.com/danalytis/pokedexcli/internal/pokeapi"
+	"github.com/danalytis/pokedexcli/internal/pokeapitest"
+	"github.com/danalytis/pokedexcli/internal/pokecache"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestChart(t *testing.T) (*Chart, *pokeapi.Client, *pokeapitest.Server) {
+	t.Helper()
+	server := pokeapitest.NewServer()
+	t.Cleanup(server.Close)
+
+	cache := pokecache.NewCache(5 * time.Minute)
+	client := pokeapi.NewClientWithBaseURL(&cache, server.BaseURL())
+	return New(client), client, server
+}
+
+func TestMultiplier(t *testing.T) {
+	chart, _, _ := newTestChart(t)
+
+	cases := []struct {
+		attacking, defending string
+		expected             float64
+	}{
+		{"fire", "grass", 2},
+		{"fire", "water", 0.5},
+		{"normal", "ghost", 0},
+		{"normal", "fire", 1},
+	}
+	for _, c := range cases {
+		t.Run(c.attacking+"-"+c.defending, func(t *testing.T) {
+			m, err := chart.Multiplier(c.attacking, c.defending)
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, m)
+		})
+	}
+}
+
+func TestEffectiveness_DualTypes(t *testing.T) {
+	chart, _, _ := newTestChart(t)
+
+	cases := []struct {
+		attacking string
+		defending []string
+		expected  float64
+	}{
+		{"ground", []string{"water", "poison"}, 2},
+		{"ice", []string{"dragon", "ground"}, 4},
+		{"electric", []string{"dragon", "ground"}, 0},
+		{"grass", []string{"grass", "poison"}, 0.25},
+	}
+	for _, c := range cases {
+		m, err := chart.Effectiveness(c.attacking, c.defending)
+		assert.NoError(t, err)
+		assert.Equal(t, c.expected, m)
+	}
+}
+
+func TestChart_CachesRows(t *testing.T) {
+	chart, _, server := newTestChart(t)
+
+	chart.Multiplier("fire", "grass")
+	chart.Multiplier("fire", "water")
+	assert.Equal(t, 1, server.Requests("type/fire"))
+}
+
+func TestChart_UnknownType(t *testing.T) {
+	chart, _, _ := newTestChart(t)
+
+	_, err := chart.Multiplier("cosmic", "fire")
+	assert.ErrorContains(t, err, "unknown type cosmic")
+}
+
+func TestLoadAll(t *testing.T) {
+	chart, _, _ := newTestChart(t)
+
+	types, err := chart.Types()
+	assert.NoError(t, err)
+	assert.Len(t, types, 18)
+	assert.Equal(t, "bug", types[0])
+}
+
+func TestSummarize(t *testing.T) {
+	chart, _, _ := newTestChart(t)
+
+	s, err := chart.Summarize("ground")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"electric", "fire", "poison", "rock", "steel"}, s.SuperEffectiveAgainst)
+	assert.Equal(t, []string{"bug", "grass"}, s.NotVeryEffective)
+	assert.Equal(t, []string{"flying"}, s.NoEffectAgainst)
+	assert.Equal(t, []string{"grass", "ice", "water"}, s.WeakTo)
+	assert.Equal(t, []string{"poison", "rock"}, s.Resists)
+	assert.Equal(t, []string{"electric"}, s.ImmuneTo)
+}
+
+func TestMatchups(t *testing.T) {
+	chart, client, _ := newTestChart(t)
+	pikachu, _ := client.GetPokemon("pikachu")
+	gible, _ := client.GetPokemon("gible")
+
+	matchups, err := chart.Matchups(gible, pikachu)
+	assert.NoError(t, err)
+	assert.Equal(t, []Matchup{{"dragon", 1}, {"ground", 2}}, matchups)
+
+	matchups, err = chart.Matchups(pikachu, gible)
+	assert.NoError(t, err)
+	assert.Equal(t, []Matchup{{"electric", 0}}, matchups)
+}
+
+func TestAnalyzeTeam(t *testing.T) {
+	chart, client, _ := newTestChart(t)
+	var team []pokeapi.Pokemon
+	for _, name := range []string{"squirtle", "tentacool", "shellos"} {
+		p, err := client.GetPokemon(name)
+		assert.NoError(t, err)
+		team = append(team, p)
+	}
+
+	analysis, err := chart.AnalyzeTeam(team)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"squirtle", "tentacool", "shellos"}, analysis.Members)
+
+	var electric, fire TypeCoverage
+	for _, d := range analysis.Defense {
+		switch d.Type {
+		case "electric":
+			electric = d
+		case "fire":
+			fire = d
+		}
+	}
+	assert.Equal(t, []string{"squirtle", "tentacool", "shellos"}, electric.Weak)
+	assert.Equal(t, []string{"squirtle", "tentacool", "shellos"}, fire.Resist)
+
+	assert.Contains(t, analysis.Threats, "electric")
+	assert.Contains(t, analysis.Threats, "grass")
+	assert.NotContains(t, analysis.Threats, "fire")
+
+	// Water and poison STAB hit fire, ground, rock, grass and fairy.
+	assert.NotContains(t, analysis.Uncovered, "fire")
+	assert.NotContains(t, analysis.Uncovered, "fairy")
+	assert.Contains(t, analysis.Uncovered, "dragon")
+}
+
+func TestAnalyzeTeam_Empty(t *testing.T) {
+	chart, _, _ := newTestChart(t)
+
+	analysis, err := chart.AnalyzeTeam(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, analysis.Threats)
+	assert.Len(t, analysis.Uncovered, 18)
+}
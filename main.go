@@ -2,23 +2,39 @@ package main
 
 import (
 	"bufio"
+	"flag"
 	"fmt"
 	"github.com/danalytis/pokedexcli/internal/pokeapi"
 	"github.com/danalytis/pokedexcli/internal/pokecache"
+	"github.com/danalytis/pokedexcli/internal/sprite"
+	"github.com/danalytis/pokedexcli/internal/typechart"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 )
 
 type config struct {
-	Client   *pokeapi.Client
-	Next     *string
-	Previous *string
+	Client *pokeapi.Client
+	// Cursors tracks each paged listing, keyed by resource, and Listing is
+	// the one shown last, which next and back move through.
+	Cursors  map[string]*cursor
+	Listing  string
+	SavePath string
+	// Wild is the Pokemon last encountered at the trainer's position, if
+	// any.
+	Wild *pokeapi.WildEncounter
+	// Scanner reads interactive input, such as move choices in battle.
+	Scanner *bufio.Scanner
+	Types   *typechart.Chart
 }
 type cliCommand struct {
 	name        string
 	description string
 	callback    func(*config, []string) error
+	// keepCase passes the arguments through as typed rather than
+	// lowercased, for free text such as nicknames and notes.
+	keepCase bool
 }
 
 func printHelp() {
@@ -33,87 +49,101 @@ func commandHelp(cfg *config, args []string) error {
 	return nil
 }
 
-func commandPokedex(cfg *config, args []string) error {
-	if len(cfg.Client.Pokedex) == 0 {
-		fmt.Println("Your Pokedex is empty.")
-		return nil
-	}
-	fmt.Println("Your Pokedex:")
-	for name := range cfg.Client.Pokedex {
-		fmt.Printf(" - %s\n", name)
-	}
-	return nil
+func commandMap(cfg *config, args []string) error {
+	return cfg.pageForward("location-area")
 }
 
-func commandMap(cfg *config, args []string) error {
-	url := "https://pokeapi.co/api/v2/location-area"
-	if cfg.Next != nil {
-		url = *cfg.Next
+func commandMapb(cfg *config, args []string) error {
+	return cfg.pageBack("location-area")
+}
+
+func commandExplore(cfg *config, name []string) error {
+	if len(name) == 0 {
+		if cfg.Client.Trainer.Position.Area == "" {
+			fmt.Println("usage: explore <location-name>")
+			return nil
+		}
+		name = []string{cfg.Client.Trainer.Position.Area}
 	}
-	locationAreasResp, err := cfg.Client.GetLocationAreas(url)
+	results, err := cfg.Client.ExploreLocation(name[0])
 	if err != nil {
 		return err
 	}
-	cfg.Next = locationAreasResp.Next
-	cfg.Previous = locationAreasResp.Previous
 
-	for _, area := range locationAreasResp.Results {
-		fmt.Println(area.Name)
+	fmt.Println("Found Pokemon:")
+	for _, encounter := range results.PokemonEncounters {
+		if err := cfg.Client.SeePokemon(encounter.Pokemon.Name); err != nil {
+			return err
+		}
+		fmt.Printf("- %s\n", describeEncounter(encounter))
 	}
 	return nil
 }
 
-func commandMapb(cfg *config, args []string) error {
-	if cfg.Previous == nil {
-		fmt.Println("you're on the first page")
+func commandCatch(cfg *config, args []string) error {
+	name, options := parseArgs(args)
+	if len(name) == 0 && cfg.Wild != nil {
+		name = []string{cfg.Wild.Pokemon}
+	}
+	if len(name) == 0 {
+		fmt.Println("usage: catch [pokemon-name] [--ball poke|great|ultra|master] [--status <status>] [--hp <percent>] [--level <level> (freecatch only)]")
 		return nil
 	}
 
-	locationAreasResp, err := cfg.Client.GetLocationAreas(*cfg.Previous)
+	// The wild Pokemon from the last encounter keeps its rolled level.
+	wild := cfg.Wild != nil && cfg.Wild.Pokemon == name[0]
+	defaultLevel := pokeapi.DefaultCatchLevel
+	if wild {
+		defaultLevel = cfg.Wild.Level
+	}
+
+	hpPercent, err := intOption(options, "hp", 100)
 	if err != nil {
 		return err
 	}
-
-	cfg.Next = locationAreasResp.Next
-	cfg.Previous = locationAreasResp.Previous
-
-	for _, area := range locationAreasResp.Results {
-		fmt.Println(area.Name)
+	// CatchPokemon treats zero as full health, so --hp is checked here.
+	if err := pokeapi.CheckHPPercent(hpPercent); err != nil {
+		return err
 	}
-	return nil
-}
-
-func commandExplore(cfg *config, name []string) error {
-	if len(name) == 0 {
-		fmt.Println("usage: explore <location-name>")
-		return nil
+	// Choosing the level would skip levelling up, so it's only allowed
+	// alongside freecatch.
+	if _, ok := options["level"]; ok && !cfg.Client.Trainer.FreeCatch {
+		return fmt.Errorf("--level only works with freecatch on")
 	}
-	results, err := cfg.Client.ExploreLocation(name[0])
+	level, err := intOption(options, "level", defaultLevel)
 	if err != nil {
 		return err
 	}
-
-	fmt.Println("Found Pokemon:")
-	for _, encounter := range results.PokemonEncounters {
-		fmt.Printf("- %s\n", encounter.Pokemon.Name)
+	if err := cfg.Client.CheckCatchable(name[0]); err != nil {
+		return err
 	}
-	return nil
-}
-
-func commandCatch(cfg *config, name []string) error {
-	if len(name) == 0 {
-		fmt.Println("usage: catch <pokemon-name>")
-		return nil
+	// What can be caught, and at what level, is decided by the encounter.
+	if !wild && !cfg.Client.Trainer.FreeCatch {
+		return fmt.Errorf("no wild %s has appeared, use encounter to look for one", name[0])
 	}
 
-	results, err := cfg.Client.CatchPokemon(name[0])
+	result, err := cfg.Client.CatchPokemon(name[0], pokeapi.CatchOptions{
+		Ball:      options["ball"],
+		Status:    options["status"],
+		HPPercent: hpPercent,
+		Location:  cfg.Client.Trainer.Position.Area,
+		Level:     level,
+	})
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("Throwing a Pokeball at %s...\n", name[0])
-	if results {
-		fmt.Printf("%s was caught!\n", name[0])
+	fmt.Printf("Throwing a %s at %s... (%.1f%% chance)\n", result.Ball.Name, name[0], result.Probability*100)
+	if result.Caught {
+		if wild {
+			cfg.Wild = nil
+		}
+		fmt.Printf("%s was caught! You earned $%d.\n", name[0], result.Reward)
+		fmt.Printf("%s (Lv. %d, %s nature) was given ID %d and sent to your %s.\n",
+			name[0], result.Entry.Level, result.Entry.Nature, result.Entry.ID, result.Place)
+		if result.Entry.HeldItem != "" {
+			fmt.Printf("%s was holding a %s!\n", name[0], result.Entry.HeldItem)
+		}
 	} else {
 		fmt.Printf("%s escaped!\n", name[0])
 	}
@@ -121,9 +151,10 @@ func commandCatch(cfg *config, name []string) error {
 	return nil
 }
 
-func commandInspect(cfg *config, name []string) error {
+func commandInspect(cfg *config, args []string) error {
+	name, options := parseArgs(args, "sprite", "shiny", "ascii")
 	if len(name) == 0 {
-		fmt.Println("usage: inspect <pokemon-name>")
+		fmt.Println("usage: inspect <pokemon-name|id|nickname|tag> [--sprite] [--shiny] [--ascii]")
 		return nil
 	}
 
@@ -131,15 +162,49 @@ func commandInspect(cfg *config, name []string) error {
 	if err != nil {
 		return err
 	}
+
+	shiny, ascii := options["shiny"] == "true", options["ascii"] == "true"
+	if options["sprite"] != "true" && !shiny && !ascii {
+		return nil
+	}
+	data, err := cfg.Client.SpriteFor(name[0], shiny)
+	if err != nil {
+		return err
+	}
+	img, err := sprite.Decode(data)
+	if err != nil {
+		return err
+	}
+	fmt.Print(sprite.Render(img, sprite.Options{ASCII: ascii}))
 	return nil
 }
 
 func commandExit(cfg *config, args []string) error {
+	if err := saveState(cfg); err != nil {
+		return err
+	}
 	fmt.Println("Closing the Pokedex... Goodbye!")
 	os.Exit(0)
 	return nil
 }
 
+// saveState persists the trainer's progress. It is a no-op when no save
+// path is configured.
+func saveState(cfg *config) error {
+	if cfg.SavePath == "" {
+		return nil
+	}
+	return cfg.Client.SaveState(cfg.SavePath)
+}
+
+func defaultSavePath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "pokedex-save.json"
+	}
+	return filepath.Join(dir, "pokedexcli", "save.json")
+}
+
 func cleanInput(text string) []string {
 
 	text = strings.TrimSpace(text)
@@ -150,7 +215,22 @@ func cleanInput(text string) []string {
 	return words
 }
 
+// commandArgs returns the arguments on an input line, lowercased unless the
+// command keeps their case.
+func commandArgs(cmd cliCommand, input string) []string {
+	words := cleanInput(input)
+	if cmd.keepCase {
+		words = strings.Fields(input)
+	}
+	return words[1:]
+}
+
 var cliCommands = map[string]cliCommand{
+	"set": {
+		name:        "set",
+		description: "Shows or changes settings: set lang <code>, set version <game>",
+		callback:    commandSet,
+	},
 	"exit": {
 		name:        "exit",
 		description: "Exit the Pokedex",
@@ -188,21 +268,214 @@ var cliCommands = map[string]cliCommand{
 	},
 	"pokedex": {
 		name:        "pokedex",
-		description: "Lists all pokemon in your pokedex",
+		description: "Lists your caught Pokemon, sorted, filtered and paged",
 		callback:    commandPokedex,
 	},
+	"progress": {
+		name:        "progress",
+		description: "Shows Pokedex completion by pokedex, generation or type",
+		callback:    commandProgress,
+	},
+	"party": {
+		name:        "party",
+		description: "Lists the Pokemon in your party",
+		callback:    commandParty,
+	},
+	"box": {
+		name:        "box",
+		description: "Lists your PC boxes, or the Pokemon in one: box <number>",
+		callback:    commandBox,
+	},
+	"deposit": {
+		name:        "deposit",
+		description: "Moves a party Pokemon to a PC box: deposit <id> [box]",
+		callback:    commandDeposit,
+	},
+	"withdraw": {
+		name:        "withdraw",
+		description: "Moves a Pokemon from a PC box to your party: withdraw <id>",
+		callback:    commandWithdraw,
+	},
+	"release": {
+		name:        "release",
+		description: "Releases a caught Pokemon: release <id>",
+		callback:    commandRelease,
+	},
+	"nickname": {
+		name:        "nickname",
+		description: "Names one of your Pokemon, or clears its nickname: nickname <id> [name]",
+		callback:    commandNickname,
+		keepCase:    true,
+	},
+	"note": {
+		name:        "note",
+		description: "Shows, saves or clears notes on one of your Pokemon: note <id> [text...] | note <id> --clear",
+		callback:    commandNote,
+		keepCase:    true,
+	},
+	"tag": {
+		name:        "tag",
+		description: "Lists your tags, or tags one of your Pokemon: tag <id> <tag> [tag...]",
+		callback:    commandTag,
+	},
+	"untag": {
+		name:        "untag",
+		description: "Removes tags from one of your Pokemon",
+		callback:    commandUntag,
+	},
+	"type": {
+		name:        "type",
+		description: "Shows a type's strengths, weaknesses and immunities",
+		callback:    commandType,
+	},
+	"matchup": {
+		name:        "matchup",
+		description: "Shows type multipliers between two pokemon: matchup <attacker> <defender>",
+		callback:    commandMatchup,
+	},
+	"compare": {
+		name:        "compare",
+		description: "Compares Pokemon side by side: compare <a> <b> [c...]",
+		callback:    commandCompare,
+	},
+	"analyze": {
+		name:        "analyze",
+		description: "Reports your party's type weaknesses and coverage: analyze team [--json]",
+		callback:    commandAnalyze,
+	},
+	"next": {
+		name:        "next",
+		description: "Shows the next page of the last listing",
+		callback:    commandNext,
+	},
+	"back": {
+		name:        "back",
+		description: "Shows the previous page of the last listing",
+		callback:    commandBack,
+	},
+	"regions": {
+		name:        "regions",
+		description: "Lists the regions of the Pokemon world",
+		callback:    commandRegions,
+	},
+	"locations": {
+		name:        "locations",
+		description: "Lists the locations in a region, or pages through all locations",
+		callback:    commandLocations,
+	},
+	"areas": {
+		name:        "areas",
+		description: "Lists the location areas that make up a location",
+		callback:    commandAreas,
+	},
+	"where": {
+		name:        "where",
+		description: "Lists where a Pokemon can be found, best odds first",
+		callback:    commandWhere,
+	},
+	"goto": {
+		name:        "goto",
+		description: "Travels to a location area in the current region",
+		callback:    commandGoto,
+	},
+	"freecatch": {
+		name:        "freecatch",
+		description: "Turns free-catch mode on or off",
+		callback:    commandFreeCatch,
+	},
+	"encounter": {
+		name:        "encounter",
+		description: "Looks for a wild Pokemon in the explored area",
+		callback:    commandEncounter,
+	},
+	"evolutions": {
+		name:        "evolutions",
+		description: "Shows a Pokemon's evolution chain and how each stage evolves",
+		callback:    commandEvolutions,
+	},
+	"evolve": {
+		name:        "evolve",
+		description: "Evolves one of your Pokemon by ID when its conditions are met",
+		callback:    commandEvolve,
+	},
+	"moves": {
+		name:        "moves",
+		description: "Lists the moves a Pokemon learns, by method, in a version group",
+		callback:    commandMoves,
+	},
+	"move": {
+		name:        "move",
+		description: "Shows a move's type, power, accuracy, PP and effect",
+		callback:    commandMove,
+	},
+	"ability": {
+		name:        "ability",
+		description: "Shows an ability's effect and the Pokemon that can have it",
+		callback:    commandAbility,
+	},
+	"battle": {
+		name:        "battle",
+		description: "Battles a wild Pokemon, or one of yours by ID",
+		callback:    commandBattle,
+	},
+	"inventory": {
+		name:        "inventory",
+		description: "Shows your money and items",
+		callback:    commandInventory,
+	},
+	"shop": {
+		name:        "shop",
+		description: "Lists items for sale, or buys one: shop buy <item> [quantity]",
+		callback:    commandShop,
+	},
+	"item": {
+		name:        "item",
+		description: "Shows an item's cost, category and effect, and a berry's flavors",
+		callback:    commandItem,
+	},
+	"use": {
+		name:        "use",
+		description: "Uses a healing item, revive, rare candy or evolution stone on a Pokemon: use <item> <id>",
+		callback:    commandUse,
+	},
 }
 
 func main() {
+	seed := flag.Int64("seed", 0, "seed for catch randomness, to replay a session (default: random)")
+	savePath := flag.String("save", defaultSavePath(), "file the Pokedex and inventory are saved to")
+	lang := flag.String("lang", pokeapi.DefaultLanguage, "language for names and Pokedex text, e.g. ja or de")
+	version := flag.String("version", "", "game version Pokedex entries come from (default: latest)")
+	flag.Parse()
+
+	// Any seed can be replayed, 0 included, so only a missing flag picks a
+	// random one.
+	seedSet := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "seed" {
+			seedSet = true
+		}
+	})
+	if !seedSet {
+		*seed = time.Now().UnixNano()
+	}
+	fmt.Printf("Random seed: %d\n", *seed)
 
 	cache := pokecache.NewCache(5 * time.Second)
 	client := pokeapi.NewClient(&cache)
-
-	cfg := &config{
-		Client: client,
+	client.Rand = pokeapi.NewRand(*seed)
+	client.Language = *lang
+	client.Version = *version
+	if err := client.LoadState(*savePath); err != nil {
+		fmt.Fprintln(os.Stderr, "Error: ", err)
+		os.Exit(1)
 	}
 
 	scanner := bufio.NewScanner(os.Stdin)
+	cfg := &config{
+		Client:   client,
+		SavePath: *savePath,
+		Scanner:  scanner,
+	}
 
 	fmt.Print("Pokedex > ")
 	for scanner.Scan() {
@@ -221,10 +494,13 @@ func main() {
 			if cleanedCommand[0] == "help" {
 				printHelp()
 			}
-			err := cmd.callback(cfg, cleanedCommand[1:])
+			err := cmd.callback(cfg, commandArgs(cmd, command))
 			if err != nil {
 				fmt.Fprintln(os.Stderr, "Error: ", err)
 			}
+			if err := saveState(cfg); err != nil {
+				fmt.Fprintln(os.Stderr, "Error: ", err)
+			}
 		}
 		fmt.Print("Pokedex > ")
 	}
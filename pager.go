@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+)
+
+// cursor is a position in a paged PokeAPI listing. Next and Previous are
+// the URLs of the neighbouring pages, nil at either end.
+type cursor struct {
+	Next     *string
+	Previous *string
+}
+
+// cursor returns the cursor for a listing such as "location-area" or
+// "region", creating it on first use.
+func (cfg *config) cursor(resource string) *cursor {
+	if cfg.Cursors == nil {
+		cfg.Cursors = make(map[string]*cursor)
+	}
+	if cfg.Cursors[resource] == nil {
+		cfg.Cursors[resource] = &cursor{}
+	}
+	return cfg.Cursors[resource]
+}
+
+// pageForward prints the next page of a listing, starting over from the
+// first page once the last has been shown.
+func (cfg *config) pageForward(resource string) error {
+	url := cfg.Client.PokeapiBaseURL + resource
+	if next := cfg.cursor(resource).Next; next != nil {
+		url = *next
+	}
+	return cfg.showPage(resource, url)
+}
+
+// pageBack prints the previous page of a listing.
+func (cfg *config) pageBack(resource string) error {
+	previous := cfg.cursor(resource).Previous
+	if previous == nil {
+		fmt.Println("you're on the first page")
+		return nil
+	}
+	return cfg.showPage(resource, *previous)
+}
+
+func (cfg *config) showPage(resource, url string) error {
+	list, err := cfg.Client.GetResourceList(url)
+	if err != nil {
+		return err
+	}
+
+	c := cfg.cursor(resource)
+	c.Next = list.Next
+	c.Previous = list.Previous
+	cfg.Listing = resource
+
+	for _, result := range list.Results {
+		fmt.Println(result.Name)
+	}
+	return nil
+}
+
+func commandNext(cfg *config, args []string) error {
+	if cfg.Listing == "" {
+		fmt.Println("Nothing to page through yet; try map, regions or locations.")
+		return nil
+	}
+	return cfg.pageForward(cfg.Listing)
+}
+
+func commandBack(cfg *config, args []string) error {
+	if cfg.Listing == "" {
+		fmt.Println("Nothing to page through yet; try map, regions or locations.")
+		return nil
+	}
+	return cfg.pageBack(cfg.Listing)
+}
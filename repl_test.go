@@ -1,10 +1,51 @@
 package main
 
 import (
+	"bufio"
+	"encoding/json"
+	"github.com/danalytis/pokedexcli/internal/pokeapi"
+	"github.com/danalytis/pokedexcli/internal/pokeapitest"
+	"github.com/danalytis/pokedexcli/internal/pokecache"
+	"github.com/danalytis/pokedexcli/internal/typechart"
 	"github.com/stretchr/testify/assert"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
+// captureStdout returns everything run prints to stdout.
+func captureStdout(t *testing.T, run func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	output := make(chan string)
+	go func() {
+		data, _ := io.ReadAll(r)
+		output <- string(data)
+	}()
+	run()
+	w.Close()
+	return <-output
+}
+
+func TestCommandArgs_KeepCase(t *testing.T) {
+	input := "NICKNAME 3 Sparky"
+	assert.Equal(t, []string{"3", "sparky"}, commandArgs(cliCommands["inspect"], input))
+	assert.Equal(t, []string{"3", "Sparky"}, commandArgs(cliCommands["nickname"], input))
+	assert.Equal(t, []string{"3", "Keep", "for", "Contests"}, commandArgs(cliCommands["note"], "note 3 Keep for Contests"))
+}
+
 func TestCleanInput_Uppercase(t *testing.T) {
 	result := cleanInput("EXPLORE PALLET-TOWN")
 	expected := []string{"explore", "pallet-town"}
@@ -33,3 +74,616 @@ func TestCommandHelp(t *testing.T) {
 	err := commandHelp(cfg, []string{})
 	assert.NoError(t, err)
 }
+
+func newTestConfig(t *testing.T) (*config, *pokeapitest.Server) {
+	t.Helper()
+	server := pokeapitest.NewServer()
+	t.Cleanup(server.Close)
+
+	cache := pokecache.NewCache(5 * time.Minute)
+	cfg := &config{
+		Client: pokeapi.NewClientWithBaseURL(&cache, server.BaseURL()),
+	}
+	return cfg, server
+}
+
+func TestCommandMap_Pagination(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+
+	err := commandMapb(cfg, []string{})
+	assert.NoError(t, err)
+
+	areas := cfg.cursor("location-area")
+
+	err = commandMap(cfg, []string{})
+	assert.NoError(t, err)
+	assert.NotNil(t, areas.Next)
+	assert.Nil(t, areas.Previous)
+
+	err = commandMap(cfg, []string{})
+	assert.NoError(t, err)
+	assert.Nil(t, areas.Next)
+	assert.NotNil(t, areas.Previous)
+
+	err = commandMapb(cfg, []string{})
+	assert.NoError(t, err)
+	assert.NotNil(t, areas.Next)
+	assert.Nil(t, areas.Previous)
+}
+
+func TestCommandNextBack_FollowLastListing(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+	assert.NoError(t, commandNext(cfg, nil))
+
+	assert.NoError(t, commandMap(cfg, nil))
+	assert.NoError(t, commandRegions(cfg, nil))
+	assert.Equal(t, "region", cfg.Listing)
+	assert.Nil(t, cfg.cursor("region").Next)
+
+	// Paging the map resumes where it left off, independent of regions.
+	assert.NoError(t, commandMap(cfg, nil))
+	assert.Nil(t, cfg.cursor("location-area").Next)
+	assert.NoError(t, commandBack(cfg, nil))
+	assert.Equal(t, "location-area", cfg.Listing)
+	assert.NotNil(t, cfg.cursor("location-area").Next)
+
+	assert.NoError(t, commandLocations(cfg, nil))
+	assert.NoError(t, commandNext(cfg, nil))
+	assert.Nil(t, cfg.cursor("location").Next)
+}
+
+func TestCommandLocationsAndAreas(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+
+	assert.NoError(t, commandLocations(cfg, []string{"kanto"}))
+	assert.Error(t, commandLocations(cfg, []string{"atlantis"}))
+	assert.NoError(t, commandAreas(cfg, []string{"mt-coronet"}))
+	assert.Error(t, commandAreas(cfg, []string{"atlantis"}))
+}
+
+func TestCommandExplore_ServerError(t *testing.T) {
+	cfg, server := newTestConfig(t)
+	server.FailWith("location-area/canalave-city-area", 500)
+
+	err := commandExplore(cfg, []string{"canalave-city-area"})
+	assert.Error(t, err)
+}
+
+func TestParseArgs(t *testing.T) {
+	positional, options := parseArgs([]string{"pikachu", "--ball", "ultra", "--json", "--hp", "25"})
+	assert.Equal(t, []string{"pikachu"}, positional)
+	assert.Equal(t, map[string]string{"ball": "ultra", "json": "true", "hp": "25"}, options)
+}
+
+func TestParseArgs_Flags(t *testing.T) {
+	positional, options := parseArgs([]string{"--sprite", "pikachu", "--ascii"}, "sprite", "ascii")
+	assert.Equal(t, []string{"pikachu"}, positional)
+	assert.Equal(t, map[string]string{"sprite": "true", "ascii": "true"}, options)
+}
+
+func TestCommandCatch_InvalidHP(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+
+	err := commandCatch(cfg, []string{"pikachu", "--hp", "lots"})
+	assert.Error(t, err)
+
+	cfg.Client.Trainer.FreeCatch = true
+	for _, hp := range []string{"0", "-5", "250"} {
+		err := commandCatch(cfg, []string{"pikachu", "--hp", hp})
+		assert.ErrorContains(t, err, "hp must be between 1 and 100", hp)
+	}
+	assert.Empty(t, cfg.Client.Trainer.Owned())
+	assert.Equal(t, 10, cfg.Client.Trainer.Items["poke-ball"])
+}
+
+func TestCommandCatch_LevelNeedsFreeCatch(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+	cfg.Client.Rand = pokeapi.NewRand(1)
+
+	err := commandCatch(cfg, []string{"pikachu", "--ball", "master", "--level", "100"})
+	assert.ErrorContains(t, err, "freecatch")
+	assert.Empty(t, cfg.Client.Trainer.Owned())
+
+	cfg.Client.Trainer.FreeCatch = true
+	cfg.Client.Trainer.AddItem("master-ball", 1)
+	assert.NoError(t, commandCatch(cfg, []string{"pikachu", "--ball", "master", "--level", "100"}))
+	if assert.Len(t, cfg.Client.Trainer.Owned(), 1) {
+		assert.Equal(t, 100, cfg.Client.Trainer.Owned()[0].Level)
+	}
+}
+
+func TestCommandShop_Buy(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+
+	err := commandShop(cfg, []string{"buy", "great-ball", "2"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, cfg.Client.Trainer.Items["great-ball"])
+
+	err = commandShop(cfg, []string{"buy", "great-ball", "many"})
+	assert.Error(t, err)
+}
+
+func TestSaveState_WritesSaveFile(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+	cfg.SavePath = t.TempDir() + "/save.json"
+
+	assert.NoError(t, saveState(cfg))
+	_, err := os.Stat(cfg.SavePath)
+	assert.NoError(t, err)
+}
+
+func TestCommandDepositWithdraw(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+	trainer := cfg.Client.Trainer
+	trainer.AddCaught(pokeapi.Pokemon{Name: "pikachu"}, "", time.Now())
+	trainer.AddCaught(pokeapi.Pokemon{Name: "gible"}, "", time.Now())
+
+	assert.NoError(t, commandDeposit(cfg, []string{"2", "3"}))
+	assert.Len(t, trainer.Boxes[2], 1)
+
+	assert.NoError(t, commandWithdraw(cfg, []string{"2"}))
+	assert.Len(t, trainer.Party, 2)
+
+	assert.Error(t, commandDeposit(cfg, []string{"gible"}))
+	assert.NoError(t, commandRelease(cfg, []string{"2"}))
+	assert.Len(t, trainer.Owned(), 1)
+}
+
+func TestCommandBattle_Scripted(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+	mewtwo, err := cfg.Client.GetPokemon("mewtwo")
+	assert.NoError(t, err)
+	caught, _, err := cfg.Client.Trainer.AddCaught(mewtwo, "", time.Now())
+	assert.NoError(t, err)
+	caught.Level = 50
+	caught.GrowthRate = "slow"
+	cfg.Client.Trainer.FreeCatch = true
+	cfg.Scanner = bufio.NewScanner(strings.NewReader("9\npsychic\n1\n1\n1\n1\n"))
+
+	err = commandBattle(cfg, []string{"squirtle", "--level", "5", "--seed", "1"})
+	assert.NoError(t, err)
+	// Beating a level 5 wild squirtle is worth 63*5/7 experience.
+	assert.Equal(t, 45, caught.Experience)
+	assert.Equal(t, pokeapi.StatSet{Defense: 1}, caught.EVs)
+}
+
+func TestCommandBattle_OwnPokemonEarnNothing(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+	for _, name := range []string{"mewtwo", "squirtle"} {
+		p, err := cfg.Client.GetPokemon(name)
+		assert.NoError(t, err)
+		caught, _, err := cfg.Client.Trainer.AddCaught(p, "", time.Now())
+		assert.NoError(t, err)
+		caught.Level = 50
+	}
+	mewtwo := cfg.Client.Trainer.Party[0]
+	mewtwo.Level = 100
+
+	err := commandBattle(cfg, []string{"1", "--with", "1"})
+	assert.ErrorContains(t, err, "can't battle itself")
+	err = commandBattle(cfg, []string{"1"})
+	assert.ErrorContains(t, err, "can't battle itself")
+
+	cfg.Scanner = bufio.NewScanner(strings.NewReader(strings.Repeat("psychic\n", 10)))
+	assert.NoError(t, commandBattle(cfg, []string{"2", "--seed", "1"}))
+	assert.Zero(t, mewtwo.Experience)
+	assert.Equal(t, pokeapi.StatSet{}, mewtwo.EVs)
+}
+
+func TestCommandBattle_Run(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+	cfg.Client.Trainer.AddCaught(pokeapi.Pokemon{Name: "pikachu"}, "", time.Now())
+	assert.NoError(t, commandGoto(cfg, []string{"wayward-cave-1f"}))
+	cfg.Scanner = bufio.NewScanner(strings.NewReader("run\n"))
+
+	err := commandBattle(cfg, []string{"gible"})
+	assert.NoError(t, err)
+}
+
+func TestCommandBattle_WildOpponentsFollowCatchRules(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+	cfg.Client.Trainer.AddCaught(pokeapi.Pokemon{Name: "pikachu"}, "", time.Now())
+	cfg.Scanner = bufio.NewScanner(strings.NewReader(strings.Repeat("run\n", 3)))
+
+	assert.ErrorContains(t, commandBattle(cfg, []string{"gible"}), "go to a location area")
+	assert.NoError(t, commandGoto(cfg, []string{"wayward-cave-1f"}))
+	assert.ErrorContains(t, commandBattle(cfg, []string{"mewtwo"}), "no wild mewtwo in wayward-cave-1f")
+	assert.ErrorContains(t, commandBattle(cfg, []string{"gible", "--level", "100"}), "freecatch")
+
+	cfg.Wild = &pokeapi.WildEncounter{EncounterSlot: pokeapi.EncounterSlot{Pokemon: "mewtwo"}, Level: 70}
+	assert.NoError(t, commandBattle(cfg, nil), "the last encounter can always be battled")
+
+	cfg.Client.Trainer.FreeCatch = true
+	assert.NoError(t, commandBattle(cfg, []string{"squirtle", "--level", "100"}))
+}
+
+func TestCommandBattle_EmptyParty(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+
+	err := commandBattle(cfg, []string{"gible"})
+	assert.ErrorContains(t, err, "no Pokemon to battle with")
+}
+
+func TestCommandTypeAndMatchup(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+
+	assert.NoError(t, commandType(cfg, []string{"dragon"}))
+	assert.Error(t, commandType(cfg, []string{"cosmic"}))
+	assert.NoError(t, commandMatchup(cfg, []string{"pikachu", "gible"}))
+	assert.Error(t, commandMatchup(cfg, []string{"pikachu", "fakemon"}))
+}
+
+func TestCommandAnalyzeTeam(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+	output := captureStdout(t, func() {
+		assert.NoError(t, commandAnalyze(cfg, []string{"team"}))
+	})
+	assert.Equal(t, "Your party is empty.\n", output)
+
+	for _, name := range []string{"pikachu", "gible"} {
+		p, err := cfg.Client.GetPokemon(name)
+		assert.NoError(t, err)
+		cfg.Client.Trainer.AddCaught(p, "", time.Now())
+	}
+	output = captureStdout(t, func() {
+		assert.NoError(t, commandAnalyze(cfg, []string{"team"}))
+	})
+	assert.Contains(t, output, "Team: pikachu, gible\n")
+	assert.Contains(t, output, "electric       0       1       1  gible\n")
+	assert.Contains(t, output, "Threats: none\n")
+
+	output = captureStdout(t, func() {
+		assert.NoError(t, commandAnalyze(cfg, []string{"team", "--json"}))
+	})
+	var fields map[string]json.RawMessage
+	assert.NoError(t, json.Unmarshal([]byte(output), &fields))
+	assert.ElementsMatch(t, []string{"members", "defense", "offense", "threats", "uncovered"}, slices.Collect(maps.Keys(fields)))
+	assert.JSONEq(t, `["pikachu", "gible"]`, string(fields["members"]))
+	assert.JSONEq(t, `[]`, string(fields["threats"]))
+
+	var analysis typechart.TeamAnalysis
+	assert.NoError(t, json.Unmarshal([]byte(output), &analysis))
+	assert.Len(t, analysis.Defense, 18)
+	assert.Contains(t, analysis.Defense, typechart.TypeCoverage{Type: "electric", Resist: []string{"pikachu"}, Immune: []string{"gible"}})
+	assert.Contains(t, analysis.Uncovered, "fairy")
+}
+
+func TestCommandEvolutionsAndEvolve(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+	assert.NoError(t, commandEvolutions(cfg, []string{"pikachu"}))
+	assert.Error(t, commandEvolutions(cfg, []string{"fakemon"}))
+
+	p, err := cfg.Client.GetPokemon("pikachu")
+	assert.NoError(t, err)
+	caught, _, err := cfg.Client.Trainer.AddCaught(p, "", time.Now())
+	assert.NoError(t, err)
+	cfg.Client.Trainer.AddItem("thunder-stone", 1)
+
+	assert.Error(t, commandEvolve(cfg, []string{"1"}))
+	assert.NoError(t, commandEvolve(cfg, []string{"1", "--item", "thunder-stone"}))
+	assert.Equal(t, "raichu", caught.Species)
+}
+
+func TestCommandEncounterThenCatch(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+	cfg.Client.Rand = pokeapi.NewRand(1)
+
+	assert.NoError(t, commandEncounter(cfg, nil))
+	assert.Nil(t, cfg.Wild)
+
+	assert.NoError(t, commandGoto(cfg, []string{"valley-windworks-area"}))
+	assert.NoError(t, commandEncounter(cfg, []string{"--method", "walk"}))
+	assert.NotNil(t, cfg.Wild)
+	wild := *cfg.Wild
+	assert.GreaterOrEqual(t, wild.Level, 13)
+	assert.LessOrEqual(t, wild.Level, 16)
+
+	cfg.Client.Trainer.AddItem("master-ball", 1)
+	assert.NoError(t, commandCatch(cfg, []string{"--ball", "master"}))
+	assert.Nil(t, cfg.Wild)
+
+	caught := cfg.Client.Trainer.Party[0]
+	assert.Equal(t, wild.Pokemon, caught.Species)
+	assert.Equal(t, wild.Level, caught.Level)
+	assert.Equal(t, "valley-windworks-area", caught.Location)
+}
+
+func TestCommandGoto_RestrictsRegionAndCatches(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+	trainer := cfg.Client.Trainer
+
+	assert.ErrorContains(t, commandCatch(cfg, []string{"gible"}), "go to a location area")
+
+	assert.NoError(t, commandGoto(cfg, []string{"wayward-cave-1f"}))
+	assert.Equal(t, pokeapi.Position{Area: "wayward-cave-1f", Location: "wayward-cave", Region: "sinnoh"}, trainer.Position)
+	assert.ErrorContains(t, commandCatch(cfg, []string{"pikachu"}), "no wild pikachu in wayward-cave-1f")
+
+	trainer.Items["master-ball"] = 1
+	assert.ErrorContains(t, commandCatch(cfg, []string{"gible", "--ball", "master"}), "use encounter")
+	assert.Empty(t, trainer.Owned())
+	assert.NoError(t, commandEncounter(cfg, nil))
+	level := cfg.Wild.Level
+	assert.NoError(t, commandCatch(cfg, []string{"gible", "--ball", "master"}))
+	assert.Equal(t, "wayward-cave-1f", trainer.Party[0].Location)
+	assert.Equal(t, level, trainer.Party[0].Level)
+
+	err := commandGoto(cfg, []string{"pallet-town-area"})
+	assert.ErrorIs(t, err, pokeapi.ErrDifferentRegion)
+	assert.Equal(t, "wayward-cave-1f", trainer.Position.Area)
+	assert.NoError(t, commandGoto(cfg, []string{"pallet-town-area", "--any-region"}))
+	assert.Equal(t, "kanto", trainer.Position.Region)
+
+	assert.NoError(t, commandFreeCatch(cfg, []string{"on"}))
+	assert.True(t, trainer.FreeCatch)
+	assert.NoError(t, commandCatch(cfg, []string{"mewtwo"}))
+}
+
+func TestCommandWhere(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+
+	output := captureStdout(t, func() {
+		assert.NoError(t, commandWhere(cfg, []string{"shellos"}))
+	})
+	// Best odds first, then by area and version.
+	assert.Equal(t, []string{
+		"shellos can be found in:",
+		" - sunyshore-city-area          diamond    walk        50%  Lv. 5-10",
+		" - canalave-city-area           diamond    surf        40%  Lv. 20-30",
+		" - canalave-city-area           pearl      surf        40%  Lv. 20-30",
+		" - valley-windworks-area        diamond    walk        40%  Lv. 14-16",
+	}, strings.Split(strings.TrimSpace(output), "\n"))
+
+	output = captureStdout(t, func() {
+		assert.NoError(t, commandWhere(cfg, []string{"tentacool", "--method", "good-rod"}))
+	})
+	assert.Equal(t, []string{
+		"tentacool can be found in:",
+		" - canalave-city-area           diamond    good-rod    40%  Lv. 15-25",
+	}, strings.Split(strings.TrimSpace(output), "\n"))
+
+	output = captureStdout(t, func() {
+		assert.NoError(t, commandWhere(cfg, []string{"gible", "--method", "surf"}))
+		assert.NoError(t, commandWhere(cfg, []string{"venusaur"}))
+	})
+	assert.Contains(t, output, "No known wild encounters for gible by surf.")
+	assert.Contains(t, output, "No known wild encounters for venusaur.")
+	assert.Error(t, commandWhere(cfg, []string{"fakemon"}))
+}
+
+func TestCommandProgress(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+	assert.NoError(t, commandProgress(cfg, nil))
+
+	assert.NoError(t, commandGoto(cfg, []string{"wayward-cave-1f"}))
+	assert.NoError(t, commandProgress(cfg, nil))
+	assert.NoError(t, commandProgress(cfg, []string{"generations"}))
+	assert.NoError(t, commandProgress(cfg, []string{"type", "dragon"}))
+	assert.NoError(t, commandProgress(cfg, []string{"dex", "kanto"}))
+	assert.Error(t, commandProgress(cfg, []string{"pokedex", "atlantis"}))
+}
+
+func TestCommandExplore_MarksSeen(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+
+	assert.NoError(t, commandExplore(cfg, []string{"canalave-city-area"}))
+	assert.Equal(t, pokeapi.DexSeen, cfg.Client.Trainer.Status("tentacool"))
+	assert.NoError(t, commandInspect(cfg, []string{"tentacool"}))
+}
+
+func TestCommandPokedex_FilterSortAndPage(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+	assert.NoError(t, commandPokedex(cfg, nil))
+
+	for _, name := range []string{"squirtle", "tentacool", "pikachu"} {
+		p, err := cfg.Client.GetPokemon(name)
+		assert.NoError(t, err)
+		cfg.Client.Trainer.AddCaught(p, "", time.Now())
+	}
+	assert.NoError(t, commandPokedex(cfg, []string{"--type", "water", "--sort", "speed"}))
+	assert.NoError(t, commandPokedex(cfg, []string{"--gen", "1", "--min-speed", "100"}))
+	assert.NoError(t, commandPokedex(cfg, []string{"--per-page", "2", "--page", "2"}))
+	assert.Error(t, commandPokedex(cfg, []string{"--per-page", "2", "--page", "3"}))
+	assert.Error(t, commandPokedex(cfg, []string{"--min-speed", "fast"}))
+	assert.Error(t, commandPokedex(cfg, []string{"--gen", "ten"}))
+}
+
+func TestCommandCompare(t *testing.T) {
+	cfg, server := newTestConfig(t)
+
+	assert.NoError(t, commandCompare(cfg, []string{"pikachu"}))
+	assert.NoError(t, commandCompare(cfg, []string{"pikachu", "gible", "squirtle"}))
+	assert.Equal(t, 1, server.Requests("pokemon/pikachu"))
+	assert.Error(t, commandCompare(cfg, []string{"pikachu", "fakemon"}))
+}
+
+func TestCompareMatchups(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+	var pokemon []pokeapi.Pokemon
+	for _, name := range []string{"pikachu", "gible", "squirtle"} {
+		p, err := cfg.Client.GetPokemon(name)
+		assert.NoError(t, err)
+		pokemon = append(pokemon, p)
+	}
+
+	matchups, err := compareMatchups(cfg.typeChart(), pokemon)
+	assert.NoError(t, err)
+	want := []struct {
+		attacker, defender, attackingType string
+		multiplier                        float64
+	}{
+		{"pikachu", "gible", "electric", 0},
+		{"pikachu", "squirtle", "electric", 2},
+		{"gible", "pikachu", "ground", 2},
+		{"gible", "squirtle", "dragon", 1},
+		{"squirtle", "pikachu", "water", 1},
+		{"squirtle", "gible", "water", 1},
+	}
+	if assert.Len(t, matchups, len(want)) {
+		for i, w := range want {
+			assert.Equal(t, pairMatchup{
+				Attacker: w.attacker,
+				Defender: w.defender,
+				Matchup:  typechart.Matchup{AttackingType: w.attackingType, Multiplier: w.multiplier},
+			}, matchups[i])
+		}
+	}
+}
+
+func TestCommandMovesAndMove(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+
+	output := captureStdout(t, func() {
+		assert.NoError(t, commandMoves(cfg, []string{"pikachu"}))
+	})
+	assert.Equal(t, []string{
+		"pikachu's moves in diamond-pearl:",
+		"level-up:",
+		" - Lv. 1   thunder-shock",
+		" - Lv. 8   thunder-wave",
+		" - Lv. 13  quick-attack",
+		"machine:",
+		" - thunderbolt",
+	}, strings.Split(strings.TrimSpace(output), "\n"))
+
+	output = captureStdout(t, func() {
+		assert.NoError(t, commandMoves(cfg, []string{"pikachu", "--method", "machine"}))
+	})
+	assert.Equal(t, []string{
+		"pikachu's moves in diamond-pearl:",
+		"machine:",
+		" - thunderbolt",
+	}, strings.Split(strings.TrimSpace(output), "\n"))
+
+	output = captureStdout(t, func() {
+		assert.NoError(t, commandMoves(cfg, []string{"pikachu", "--version-group", "red-blue"}))
+		assert.NoError(t, commandMoves(cfg, []string{"pikachu", "--method", "egg"}))
+	})
+	assert.Contains(t, output, "pikachu's moves in red-blue:\nlevel-up:\n - Lv. 1   thunder-shock\n")
+	assert.NotContains(t, output, "thunderbolt")
+	assert.Contains(t, output, "pikachu learns no moves by egg in diamond-pearl.")
+	assert.Error(t, commandMoves(cfg, []string{"pikachu", "--version-group", "x-y"}))
+
+	output = captureStdout(t, func() {
+		assert.NoError(t, commandMove(cfg, []string{"thunder-wave"}))
+	})
+	assert.Contains(t, output, " - type: electric (status)\n - power: -, accuracy: 90, PP: 20, priority: 0\n")
+	assert.Error(t, commandMove(cfg, []string{"splash"}))
+}
+
+func TestCommandAbility(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+
+	output := captureStdout(t, func() {
+		assert.NoError(t, commandAbility(cfg, []string{"static"}))
+	})
+	assert.Contains(t, output, "Effect: Has a 30% chance of paralyzing attacking Pokemon on contact.\n")
+	assert.Contains(t, output, " - pikachu\n")
+	assert.NotContains(t, output, "(hidden ability)")
+
+	output = captureStdout(t, func() {
+		assert.NoError(t, commandAbility(cfg, []string{"lightning-rod"}))
+	})
+	assert.Contains(t, output, " - pikachu (hidden ability)\n")
+	assert.Contains(t, output, " - raichu (hidden ability)\n")
+
+	output = captureStdout(t, func() {
+		assert.NoError(t, commandAbility(cfg, []string{"guts"}))
+	})
+	assert.NotContains(t, output, "Effect:")
+	assert.Contains(t, output, "Pokemon:\n - machop\n")
+	assert.Error(t, commandAbility(cfg, []string{"wonder-guard"}))
+}
+
+func TestCommandSet_LanguageAndVersion(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+	assert.NoError(t, commandSet(cfg, nil))
+
+	assert.NoError(t, commandSet(cfg, []string{"lang", "ja"}))
+	assert.Equal(t, "ja", cfg.Client.Language)
+	assert.NoError(t, commandSet(cfg, []string{"version", "red"}))
+	assert.Equal(t, "red", cfg.Client.Version)
+	assert.NoError(t, commandSet(cfg, []string{"version", "latest"}))
+	assert.Equal(t, "", cfg.Client.Version)
+	assert.Error(t, commandSet(cfg, []string{"volume", "11"}))
+
+	p, err := cfg.Client.GetPokemon("pikachu")
+	assert.NoError(t, err)
+	cfg.Client.Trainer.AddCaught(p, "", time.Now())
+	assert.NoError(t, commandInspect(cfg, []string{"pikachu"}))
+}
+
+func TestCommandInspect_Sprite(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+	assert.Error(t, commandInspect(cfg, []string{"gible", "--sprite"}))
+
+	cfg.Client.Trainer.See("gible")
+	assert.NoError(t, commandInspect(cfg, []string{"gible", "--sprite"}))
+	assert.NoError(t, commandInspect(cfg, []string{"gible", "--shiny", "--ascii"}))
+	assert.NoError(t, commandInspect(cfg, []string{"--sprite", "gible"}))
+}
+
+func TestCommandItemAndUse(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+	assert.NoError(t, commandItem(cfg, []string{"potion"}))
+	assert.NoError(t, commandItem(cfg, []string{"oran-berry"}))
+	assert.Error(t, commandItem(cfg, []string{"leftovers"}))
+
+	p, err := cfg.Client.GetPokemon("pikachu")
+	assert.NoError(t, err)
+	cp, _, err := cfg.Client.Trainer.AddCaught(p, "", time.Now())
+	assert.NoError(t, err)
+	cp.Level = 10
+	cp.SetHP(0)
+	cp.HeldItem = "light-ball"
+	assert.NoError(t, commandInspect(cfg, []string{"pikachu"}))
+
+	err = commandBattle(cfg, []string{"gible", "--with", strconv.Itoa(cp.ID)})
+	assert.ErrorContains(t, err, "has fainted")
+
+	cfg.Client.Trainer.AddItem("revive", 1)
+	cfg.Client.Trainer.AddItem("thunder-stone", 1)
+	assert.NoError(t, commandUse(cfg, []string{"revive", strconv.Itoa(cp.ID)}))
+	assert.False(t, cp.Fainted())
+	assert.NoError(t, commandUse(cfg, []string{"thunder-stone", strconv.Itoa(cp.ID)}))
+	assert.Equal(t, "raichu", cp.Species)
+	assert.Error(t, commandUse(cfg, []string{"potion", strconv.Itoa(cp.ID)}))
+}
+
+func TestCommandLabels(t *testing.T) {
+	cfg, _ := newTestConfig(t)
+	assert.NoError(t, commandTag(cfg, nil))
+
+	p, err := cfg.Client.GetPokemon("pikachu")
+	assert.NoError(t, err)
+	cp, _, err := cfg.Client.Trainer.AddCaught(p, "", time.Now())
+	assert.NoError(t, err)
+	id := strconv.Itoa(cp.ID)
+
+	assert.NoError(t, commandNickname(cfg, []string{id, "sparky"}))
+	assert.NoError(t, commandNote(cfg, []string{id, "keep", "for", "contests"}))
+	assert.NoError(t, commandTag(cfg, []string{id, "competitive", "starter"}))
+	assert.NoError(t, commandUntag(cfg, []string{id, "starter"}))
+	assert.Equal(t, "sparky", cp.Nickname)
+	assert.Equal(t, "keep for contests", cp.Notes)
+	assert.Equal(t, []string{"competitive"}, cp.Tags)
+
+	assert.NoError(t, commandInspect(cfg, []string{"sparky"}))
+	assert.NoError(t, commandInspect(cfg, []string{"competitive"}))
+	assert.NoError(t, commandPokedex(cfg, []string{"--tag", "competitive"}))
+	assert.NoError(t, commandPokedex(cfg, []string{"--note", "contests"}))
+	assert.NoError(t, commandTag(cfg, nil))
+
+	assert.Error(t, commandNote(cfg, []string{id, "more", "--clear"}))
+	assert.Equal(t, "keep for contests", cp.Notes)
+	assert.NoError(t, commandNote(cfg, []string{id, "--clear"}))
+	assert.Empty(t, cp.Notes)
+	assert.NoError(t, commandNickname(cfg, []string{id}))
+	assert.Empty(t, cp.Nickname)
+	assert.Error(t, commandUntag(cfg, []string{id, "starter"}))
+
+	second, _, err := cfg.Client.Trainer.AddCaught(p, "", time.Now())
+	assert.NoError(t, err)
+	assert.NoError(t, commandTag(cfg, []string{strconv.Itoa(second.ID), "competitive"}))
+	assert.ErrorContains(t, commandInspect(cfg, []string{"competitive"}), "pick one by ID")
+	assert.Error(t, commandNickname(cfg, []string{id, "pikachu"}))
+}